@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var gitHistoryCmd = &cobra.Command{
+	Use:     "history",
+	Short:   "List the promotions recorded in git history, newest first",
+	Example: "cresta-releaser git history customer-namespace --ref origin/main",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		application := ""
+		if len(args) > 0 {
+			application = args[0]
+		}
+		history, err := api.PromotionHistory(cmd.Context(), *gitHistoryRef, application, *gitHistoryLimit)
+		cobra.CheckErr(err)
+		return getOutputFormat().WriteObject(os.Stdout, history)
+	},
+	Args: cobra.MaximumNArgs(1),
+}
+
+var gitHistoryRef *string
+var gitHistoryLimit *int
+
+func init() {
+	gitHistoryRef = gitHistoryCmd.Flags().String("ref", "HEAD", "Git ref whose history is searched")
+	gitHistoryLimit = gitHistoryCmd.Flags().Int("limit", 20, "Maximum number of promotions to list.  Zero lists every promotion")
+	gitCmd.AddCommand(gitHistoryCmd)
+}
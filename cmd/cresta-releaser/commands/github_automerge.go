@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/spf13/cobra"
+)
+
+var githubAutoMergeCmd = &cobra.Command{
+	Use:     "automerge",
+	Short:   "Enable auto-merge on a pull request for the current repository, so GitHub merges it once reviews and checks pass",
+	Example: "cresta-releaser github automerge 1121 --method squash",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		prNumber := args[0]
+		prAsInt, err := strconv.ParseInt(prNumber, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pull request number: %s", prNumber)
+		}
+		return api.EnableAutoMergeForCurrentRemote(cmd.Context(), prAsInt, releaser.MergeMethod(*githubAutoMergeMethod))
+	},
+	Args: cobra.ExactValidArgs(1),
+}
+
+var githubAutoMergeMethod *string
+
+func init() {
+	githubAutoMergeMethod = githubAutoMergeCmd.Flags().String("method", string(releaser.MergeMethodSquash), "Merge method: merge, squash or rebase")
+	githubCmd.AddCommand(githubAutoMergeCmd)
+}
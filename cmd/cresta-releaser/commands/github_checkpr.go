@@ -17,16 +17,26 @@ var githubCheckPrCmd = &cobra.Command{
 			_, _ = fmt.Fprintln(os.Stderr, "Error:", err)
 			os.Exit(2)
 		}
-		if prNum != 0 {
-			fmt.Println(prNum)
-		} else {
+		if prNum == 0 {
 			os.Exit(1)
 		}
-		return nil
+		if !*githubCheckPrDetails {
+			fmt.Println(prNum)
+			return nil
+		}
+		details, err := api.PullRequestDetails(cmd.Context(), prNum)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(2)
+		}
+		return getOutputFormat().WriteObject(os.Stdout, details)
 	},
 	Args: cobra.NoArgs,
 }
 
+var githubCheckPrDetails *bool
+
 func init() {
+	githubCheckPrDetails = githubCheckPrCmd.Flags().Bool("details", false, "Print the state, review decision, mergeability and checks of the PR instead of just its number")
 	githubCmd.AddCommand(githubCheckPrCmd)
 }
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/spf13/cobra"
+)
+
+var githubDeployCmd = &cobra.Command{
+	Use:     "deploy",
+	Short:   "Create a GitHub deployment for a merged promotion pull request, in the environment of the release it promoted into",
+	Example: "cresta-releaser github deploy 1121",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		prAsInt, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pull request number: %s", args[0])
+		}
+		deployment, err := api.DeployPullRequest(cmd.Context(), prAsInt)
+		if err != nil {
+			return err
+		}
+		return getOutputFormat().WriteObject(os.Stdout, deployment)
+	},
+	Args: cobra.ExactValidArgs(1),
+}
+
+var githubDeploymentsCmd = &cobra.Command{
+	Use:     "deployments",
+	Short:   "List the most recent GitHub deployments of a release, newest first",
+	Example: "cresta-releaser github deployments my-app 01-staging",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deployments, err := api.Deployments(cmd.Context(), args[0], args[1])
+		if err != nil {
+			return err
+		}
+		return getOutputFormat().WriteObject(os.Stdout, deployments)
+	},
+	Args: cobra.ExactValidArgs(2),
+}
+
+var githubDeploymentStatusCmd = &cobra.Command{
+	Use:     "deployment-status",
+	Short:   "Report on the progress of a GitHub deployment: queued, pending, in_progress, success, failure, error or inactive",
+	Example: "cresta-releaser github deployment-status 123456 success --description 'Rolled out'",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deploymentID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid deployment id: %s", args[0])
+		}
+		return api.SetDeploymentStatus(cmd.Context(), deploymentID, releaser.DeploymentState(args[1]), *githubDeploymentStatusDescription)
+	},
+	Args: cobra.ExactValidArgs(2),
+}
+
+var githubDeploymentStatusDescription *string
+
+func init() {
+	githubDeploymentStatusDescription = githubDeploymentStatusCmd.Flags().String("description", "", "Short description of the status")
+	githubCmd.AddCommand(githubDeployCmd)
+	githubCmd.AddCommand(githubDeploymentsCmd)
+	githubCmd.AddCommand(githubDeploymentStatusCmd)
+}
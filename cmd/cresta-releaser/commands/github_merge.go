@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -20,11 +21,24 @@ var githubMergeCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("invalid pull request number: %s", prNumber)
 		}
-		return api.MergePullRequestForCurrentRemote(cmd.Context(), prAsInt)
+		if err := api.MergePullRequestForCurrentRemote(cmd.Context(), prAsInt); err != nil {
+			return err
+		}
+		if !*githubMergeDeploy {
+			return nil
+		}
+		deployment, err := api.DeployPullRequest(cmd.Context(), prAsInt)
+		if err != nil {
+			return err
+		}
+		return getOutputFormat().WriteObject(os.Stdout, deployment)
 	},
 	Args: cobra.ExactValidArgs(1),
 }
 
+var githubMergeDeploy *bool
+
 func init() {
+	githubMergeDeploy = githubMergeCmd.Flags().Bool("deploy", false, "Create a GitHub deployment for the merged promotion")
 	githubCmd.AddCommand(githubMergeCmd)
 }
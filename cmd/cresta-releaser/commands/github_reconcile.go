@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var githubReconcileCmd = &cobra.Command{
+	Use:     "reconcile",
+	Short:   "Close promotion pull requests that are no longer needed and delete their branches.  Run from an up to date checkout of the default branch",
+	Example: "cresta-releaser github reconcile --dry-run",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		closed, err := api.ReconcilePullRequests(cmd.Context(), *githubReconcileDryRun)
+		cobra.CheckErr(err)
+		return getOutputFormat().WriteObject(os.Stdout, closed)
+	},
+	Args: cobra.NoArgs,
+}
+
+var githubReconcileDryRun *bool
+
+func init() {
+	githubReconcileDryRun = githubReconcileCmd.Flags().Bool("dry-run", false, "Only list the pull requests that would be closed")
+	githubCmd.AddCommand(githubReconcileCmd)
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/spf13/cobra"
+)
+
+var releasePromoteCmd = &cobra.Command{
+	Use:     "promote",
+	Short:   "Promote a release with a pull request, or onto the default branch if the release is in direct mode",
+	Example: "cresta-releaser release promote customer-namespace 00-dev",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		result, err := releaser.Promote(cmd.Context(), api, args[0], args[1])
+		cobra.CheckErr(err)
+		return getOutputFormat().WriteObject(os.Stdout, result)
+	},
+	Args: cobra.ExactValidArgs(2),
+}
+
+func init() {
+	releaseCmd.AddCommand(releasePromoteCmd)
+}
@@ -23,12 +23,27 @@ var rootCmd = &cobra.Command{
 		if *verbose {
 			cfg.Level.SetLevel(zap.DebugLevel)
 		}
-		logger, err := cfg.Build()
+		logger, err := cfg.Build(zap.WrapCore(releaser.RedactingCore))
 		if err != nil {
 			return err
 		}
-		api, err = releaser.NewFromCommandLine(cmd.Context(), logger, nil)
-		return err
+		cli, err := releaser.NewFromCommandLine(cmd.Context(), logger, nil)
+		if err != nil {
+			return err
+		}
+		if gitCli, ok := cli.Git.(*releaser.GitCli); ok {
+			gitCli.DefaultBranchName = *defaultBranch
+			if *signingKey != "" || *signingFormat != "" {
+				gitCli.Signing = &releaser.CommitSigning{
+					Format: *signingFormat,
+					Key:    *signingKey,
+				}
+			}
+		}
+		cli.CommitThroughGithub = *commitThroughGithub
+		cli.OverwriteForeignCommits = *overwriteForeignCommits
+		api = cli
+		return nil
 	},
 }
 
@@ -67,8 +82,18 @@ func getOutputFormat() OutputFormatter {
 
 var outputFormat *string
 var verbose *bool
+var defaultBranch *string
+var signingKey *string
+var signingFormat *string
+var commitThroughGithub *bool
+var overwriteForeignCommits *bool
 
 func init() {
 	outputFormat = rootCmd.PersistentFlags().StringP("output", "o", "", "Output format of the command")
 	verbose = rootCmd.PersistentFlags().BoolP("verbose", "v", false, "If true, will print out verbose logging")
+	defaultBranch = rootCmd.PersistentFlags().String("default-branch", "", "Branch releases are cut from.  Defaults to the default branch of origin")
+	signingKey = rootCmd.PersistentFlags().String("signing-key", "", "Sign release commits with this GPG key ID, or SSH key path with --signing-format ssh")
+	signingFormat = rootCmd.PersistentFlags().String("signing-format", "", "Signature format of release commits: openpgp, x509 or ssh")
+	commitThroughGithub = rootCmd.PersistentFlags().Bool("commit-through-github", false, "Create release commits with the GitHub API, so GitHub signs them")
+	overwriteForeignCommits = rootCmd.PersistentFlags().Bool("overwrite-foreign-commits", false, "Allow pushes to destroy commits on the release branch that the releaser did not create")
 }
@@ -3,14 +3,15 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/cresta/cresta-releaser/internal/logging"
-	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
 	"github.com/cresta/cresta-releaser/internal/releaserserver"
-	"github.com/cresta/cresta-releaser/releaser"
 	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/twitchtv/twirp"
 	"go.uber.org/zap"
 )
 
@@ -18,25 +19,73 @@ func main() {
 	ctx := context.Background()
 	logger := MustReturn(logging.SetupLogging(envWithDefault("LOG_LEVEL", "info")))
 	logger.Info(ctx, "Starting application")
-	api := MustReturn(releaser.NewFromCommandLine(ctx, logger.Unwrap(ctx), nil))
-	repo := MustReturn(managedgitrepo.NewRepo(ctx, envWithDefault("REPO_DISK_LOCATION", "/tmp/repo"), os.Getenv("REPO_URL"), api.Fs, api.Github, api.Git))
-	serverImpl := MustReturn(releaserserver.NewServer(ctx, logger, api, repo))
-	twirpServer := releaser_protobuf.NewReleaserServer(serverImpl)
-	Must(os.Chdir(envWithDefault("REPO_DISK_LOCATION", "/tmp/repo")))
-	Must(repo.VerifyOrSetAuthorInfo(ctx, os.Getenv("GIT_AUTHOR_NAME"), os.Getenv("GIT_AUTHOR_EMAIL")))
-	mux := muxWithHealthCheckForTwirp(twirpServer)
+	cfg := MustReturn(loadConfig())
+	repositories := make([]*releaserserver.Repository, 0, len(cfg.Repositories))
+	for _, repoCfg := range cfg.Repositories {
+		logger.Info(ctx, "setting up repository", zap.String("id", repoCfg.ID), zap.String("disk_location", repoCfg.DiskLocation))
+		repositories = append(repositories, MustReturn(releaserserver.NewRepository(ctx, logger.Unwrap(ctx), repoCfg, os.Getenv("GIT_AUTHOR_NAME"), os.Getenv("GIT_AUTHOR_EMAIL"))))
+	}
+	serverImpl := MustReturn(releaserserver.NewServer(ctx, logger, repositories))
+	twirpServer := releaser_protobuf.NewReleaserServer(serverImpl, twirp.WithServerInterceptors(releaserserver.RedactErrors))
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	var webhooks http.Handler
+	var webhookHandler *releaserserver.WebhookHandler
+	if secret := os.Getenv("WEBHOOK_SECRET"); secret != "" {
+		webhookHandler = MustReturn(releaserserver.NewWebhookHandler(ctxWithCancel, serverImpl, secret))
+		webhooks = webhookHandler
+	}
+	mux := muxWithHealthCheckForTwirp(twirpServer, http.HandlerFunc(serverImpl.ServeReadiness), http.HandlerFunc(serverImpl.ServeEvents), webhooks)
 	httpServer := http.Server{
 		Addr:    envWithDefault("LISTEN_ADDR", ":8080"),
 		Handler: mux,
 	}
-	ctxWithCancel, cancel := context.WithCancel(ctx)
+	// Event streams never end on their own, so Shutdown would wait for them forever
+	httpServer.RegisterOnShutdown(serverImpl.CloseEvents)
 	releaserserver.CronRefresh(ctxWithCancel, serverImpl, envWithDefaultTime("CRON_REFRESH_INTERVAL", 0))
-	killOnSigTerm(ctx, logger, &httpServer)
+	releaserserver.WatchStatus(ctxWithCancel, serverImpl, envWithDefaultTime("STATUS_EVENTS_INTERVAL", 30*time.Second))
+	shutdown := killOnSigTerm(ctx, logger, &httpServer)
 	logger.Info(ctx, "starting server", zap.String("addr", httpServer.Addr))
 	err := httpServer.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		// ListenAndServe returns as soon as Shutdown starts, while requests may still be running
+		<-shutdown
+	}
 	cancel()
+	if webhookHandler != nil {
+		webhookHandler.Wait()
+	}
 	logger.Info(ctx, "server stopped", zap.Error(err))
 	if !errors.Is(err, http.ErrServerClosed) {
 		logger.Error(ctx, "http server error", zap.Error(err))
 	}
 }
+
+// loadConfig reads the repositories to manage from CONFIG_FILE if set.  Otherwise, it manages the single repository
+// described by REPO_URL.
+func loadConfig() (*releaserserver.Config, error) {
+	if configFile := os.Getenv("CONFIG_FILE"); configFile != "" {
+		return releaserserver.LoadConfig(configFile, envWithDefault("REPO_DISK_ROOT", "/tmp/repos"))
+	}
+	cfg := releaserserver.SingleRepositoryConfig(releaserserver.RepositoryConfig{
+		ID:            envWithDefault("REPO_ID", "default"),
+		URL:           os.Getenv("REPO_URL"),
+		DiskLocation:  envWithDefault("REPO_DISK_LOCATION", "/tmp/repo"),
+		DefaultBranch: os.Getenv("REPO_DEFAULT_BRANCH"),
+		Provider:      os.Getenv("REPO_PROVIDER"),
+		Signing: releaserserver.SigningConfig{
+			Format:        os.Getenv("REPO_SIGNING_FORMAT"),
+			Key:           os.Getenv("REPO_SIGNING_KEY"),
+			ThroughGithub: os.Getenv("REPO_COMMIT_THROUGH_GITHUB") == "true",
+		},
+		Fetch: releaserserver.FetchConfig{
+			Filter: os.Getenv("REPO_CLONE_FILTER"),
+			Sparse: os.Getenv("REPO_SPARSE_CHECKOUT") == "true",
+		},
+		ReconcilePullRequests: os.Getenv("REPO_RECONCILE_PULL_REQUESTS") == "true",
+		Deployments:           os.Getenv("REPO_DEPLOYMENTS") == "true",
+	})
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid repository config: %w", err)
+	}
+	return cfg, nil
+}
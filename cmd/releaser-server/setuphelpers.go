@@ -36,16 +36,25 @@ func envWithDefault(s string, defaultVal string) string {
 	}
 }
 
-func muxWithHealthCheckForTwirp(twirpServer releaser_protobuf.TwirpServer) *mux2.Router {
+// muxWithHealthCheckForTwirp serves twirpServer, a health check, a readiness check at /readyz, status events at
+// /events, and webhooks at /webhook unless webhooks is nil
+func muxWithHealthCheckForTwirp(twirpServer releaser_protobuf.TwirpServer, readiness http.Handler, events http.Handler, webhooks http.Handler) *mux2.Router {
 	mux := mux2.NewRouter()
 	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
+	mux.Handle("/readyz", readiness).Methods(http.MethodGet)
+	mux.Handle("/events", events).Methods(http.MethodGet)
+	if webhooks != nil {
+		mux.Handle("/webhook", webhooks).Methods(http.MethodPost)
+	}
 	mux.NewRoute().PathPrefix(twirpServer.PathPrefix()).Handler(twirpServer).Methods(http.MethodPost)
 	return mux
 }
 
-func killOnSigTerm(ctx context.Context, logger *zapctx.Logger, httpServer *http.Server) {
+// killOnSigTerm shuts httpServer down on a signal.  The returned channel is closed once the shutdown finished.
+func killOnSigTerm(ctx context.Context, logger *zapctx.Logger, httpServer *http.Server) <-chan struct{} {
+	done := make(chan struct{})
 	sigc := make(chan os.Signal, 1)
 	signal.Notify(sigc,
 		syscall.SIGHUP,
@@ -53,12 +62,14 @@ func killOnSigTerm(ctx context.Context, logger *zapctx.Logger, httpServer *http.
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 	go func() {
+		defer close(done)
 		<-sigc
 		logger.Info(ctx, "shutting down", zap.String("reason", "signal"))
 		if err := httpServer.Shutdown(ctx); err != nil {
 			logger.Error(ctx, "failed to shutdown http server", zap.Error(err))
 		}
 	}()
+	return done
 }
 
 func envWithDefaultTime(s string, defaultValue time.Duration) time.Duration {
@@ -3,6 +3,7 @@ package logging
 import (
 	"fmt"
 
+	"github.com/cresta/cresta-releaser/releaser"
 	"github.com/cresta/zapctx"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -17,7 +18,7 @@ func SetupLogging(level string) (*zapctx.Logger, error) {
 		}
 		cfg.Level.SetLevel(l)
 	}
-	logger, err := cfg.Build()
+	logger, err := cfg.Build(zap.WrapCore(releaser.RedactingCore))
 	if err != nil {
 		return nil, fmt.Errorf("unable to setup logging: %w", err)
 	}
@@ -3,9 +3,8 @@ package managedgitrepo
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/cresta/cresta-releaser/releaser"
 )
@@ -14,20 +13,40 @@ type Repo struct {
 	DiskLocation string
 	URL          string
 	Fs           releaser.FileSystem
-	Gh           releaser.GitHub
+	Host         releaser.CodeHost
 	G            releaser.Git
+	// NewGit creates the Git used to operate inside a directory of this repository (the main checkout or a worktree).
+	// Access tokens are never stored in the repository, so the Git must authenticate with origin on its own, for
+	// example with GitCli.Credentials.
+	NewGit func(dir string) releaser.Git
+	// NewFs creates the FileSystem rooted at a directory of this repository (the main checkout or a worktree)
+	NewFs func(dir string) releaser.FileSystem
+
+	// fetchMu serializes fetches, since every worktree shares the same remote refs
+	fetchMu sync.Mutex
+	// worktreeMu serializes adding and removing worktrees
+	worktreeMu sync.Mutex
+	// snapshotMu is held for reading while the snapshot is in use, and for writing while it moves
+	snapshotMu  sync.RWMutex
+	snapshot    *Worktree
+	snapshotSha string
 }
 
-func NewRepo(ctx context.Context, diskLocation string, url string, fs releaser.FileSystem, gh releaser.GitHub, g releaser.Git) (*Repo, error) {
+func NewRepo(ctx context.Context, diskLocation string, url string, host releaser.CodeHost, newGit func(dir string) releaser.Git, newFs func(dir string) releaser.FileSystem) (*Repo, error) {
 	r := &Repo{
 		DiskLocation: diskLocation,
 		URL:          url,
-		Fs:           fs,
-		Gh:           gh,
-		G:            g,
+		Fs:           newFs(diskLocation),
+		Host:         host,
+		G:            newGit(diskLocation),
+		NewGit:       newGit,
+		NewFs:        newFs,
 	}
 	if releaser.IsGitCheckout(r.Fs, diskLocation) {
-		return r, r.ResetExistingToOrigin(ctx)
+		if err := r.ResetExistingToOrigin(ctx); err != nil {
+			return nil, err
+		}
+		return r, r.cleanupWorktrees(ctx)
 	}
 	if err := r.Clone(ctx); err != nil {
 		return nil, fmt.Errorf("failed to clone repo: %w", err)
@@ -48,62 +67,54 @@ func (r *Repo) VerifyOrSetAuthorInfo(ctx context.Context, name string, email str
 }
 
 func (r *Repo) ResetExistingToOrigin(ctx context.Context) error {
-	cloneURL, err := r.urlWithToken(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get clone url: %w", err)
-	}
-	currentDir, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("failed to get current dir: %w", err)
-	}
-	defer func() {
-		if err := os.Chdir(currentDir); err != nil {
-			panic(err)
-		}
-	}()
-	if os.Chdir(r.DiskLocation) != nil {
-		return fmt.Errorf("failed to chdir to repo: %w", err)
-	}
-	if err := r.G.ChangeOrigin(ctx, cloneURL); err != nil {
+	// Older versions stored an access token inside the origin URL.  Setting the plain URL removes it from .git/config.
+	if err := r.G.ChangeOrigin(ctx, r.URL); err != nil {
 		return fmt.Errorf("failed to change origin: %w", err)
 	}
+	if err := r.G.ApplySparseCheckout(ctx); err != nil {
+		return fmt.Errorf("failed to apply sparse checkout: %w", err)
+	}
 	if err := r.G.ResetClean(ctx); err != nil {
 		return fmt.Errorf("failed to reset clean: %w", err)
 	}
+	if err := r.ensureDefaultBranch(ctx); err != nil {
+		return fmt.Errorf("failed to find default branch: %w", err)
+	}
 	if err := r.G.ResetToOriginalBranch(ctx); err != nil {
 		return fmt.Errorf("failed to reset to original branch: %w", err)
 	}
 	return nil
 }
 
-func (r *Repo) Clone(ctx context.Context) error {
-	if isGitCheckout(ctx, r.Fs, r.DiskLocation) {
+// ensureDefaultBranch makes sure the Git layer can find the default branch of origin.  If git cannot detect it, we
+// ask the code host and record the answer as origin's HEAD.
+func (r *Repo) ensureDefaultBranch(ctx context.Context) error {
+	if _, err := r.G.DefaultBranch(ctx); err == nil {
 		return nil
 	}
-	cloneURL, err := r.urlWithToken(ctx)
+	owner, name, err := r.G.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	branch, err := r.Host.DefaultBranch(ctx, owner, name)
 	if err != nil {
-		return fmt.Errorf("failed to get clone url with token added: %w", err)
+		return fmt.Errorf("failed to get default branch of %s/%s: %w", owner, name, err)
 	}
-	return r.G.CloneURL(ctx, cloneURL, r.DiskLocation)
+	if err := r.G.SetRemoteDefaultBranch(ctx, branch); err != nil {
+		return fmt.Errorf("failed to set default branch: %w", err)
+	}
+	return nil
 }
 
-func (r *Repo) UpdateCheckout(ctx context.Context) error {
-	return r.G.FetchAllFromRemote(ctx)
+func (r *Repo) Clone(ctx context.Context) error {
+	if isGitCheckout(ctx, r.Fs, r.DiskLocation) {
+		return nil
+	}
+	return r.G.CloneURL(ctx, r.URL, r.DiskLocation)
 }
 
-func (r *Repo) urlWithToken(ctx context.Context) (string, error) {
-	x, err := url.Parse(r.URL)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse url %s: %w", r.URL, err)
-	}
-	token, err := r.Gh.GetAccessToken(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to get access token: %w", err)
-	}
-	fmt.Println(x.String())
-	x.User = url.UserPassword("x-access-token", token)
-	fmt.Println(x.String())
-	return x.String(), nil
+func (r *Repo) UpdateCheckout(ctx context.Context) error {
+	return r.G.FetchAllFromRemote(ctx)
 }
 
 func isGitCheckout(_ context.Context, fs releaser.FileSystem, path string) bool {
@@ -0,0 +1,123 @@
+package managedgitrepo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/cresta/cresta-releaser/releaser"
+)
+
+// Worktree is an extra checkout of a Repo.  It shares the object store and refs of the main checkout, so it is cheap
+// to create and lets operations run without touching the main checkout.
+type Worktree struct {
+	Dir  string
+	G    releaser.Git
+	Fs   releaser.FileSystem
+	repo *Repo
+}
+
+// Close removes the worktree from disk
+func (w *Worktree) Close(ctx context.Context) error {
+	w.repo.worktreeMu.Lock()
+	defer w.repo.worktreeMu.Unlock()
+	if err := w.repo.G.RemoveWorktree(ctx, w.Dir); err != nil {
+		return fmt.Errorf("failed to remove worktree %s: %w", w.Dir, err)
+	}
+	return nil
+}
+
+// worktreeRoot is where worktrees live.  It is inside .git so that cleaning the main checkout never removes them.
+func (r *Repo) worktreeRoot() string {
+	return filepath.Join(r.DiskLocation, ".git", "releaser-worktrees")
+}
+
+var invalidWorktreeChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// NewWorktree creates a worktree named name, detached at ref.  Any previous worktree with the same name is removed.
+func (r *Repo) NewWorktree(ctx context.Context, name string, ref string) (*Worktree, error) {
+	r.worktreeMu.Lock()
+	defer r.worktreeMu.Unlock()
+	dir := filepath.Join(r.worktreeRoot(), invalidWorktreeChars.ReplaceAllString(name, "_"))
+	if _, err := os.Stat(dir); err == nil {
+		if err := r.G.RemoveWorktree(ctx, dir); err != nil {
+			return nil, fmt.Errorf("failed to remove previous worktree %s: %w", dir, err)
+		}
+	}
+	if err := r.G.AddWorktree(ctx, dir, ref); err != nil {
+		return nil, fmt.Errorf("failed to add worktree %s: %w", name, err)
+	}
+	return &Worktree{
+		Dir:  dir,
+		G:    r.NewGit(dir),
+		Fs:   r.NewFs(dir),
+		repo: r,
+	}, nil
+}
+
+// cleanupWorktrees removes worktrees left behind by a previous run of the process
+func (r *Repo) cleanupWorktrees(ctx context.Context) error {
+	r.worktreeMu.Lock()
+	defer r.worktreeMu.Unlock()
+	if err := os.RemoveAll(r.worktreeRoot()); err != nil {
+		return fmt.Errorf("failed to remove old worktrees: %w", err)
+	}
+	return r.G.PruneWorktrees(ctx)
+}
+
+// Fetch updates the remote refs shared by every worktree.  Unless force is set, fetches are rate limited.
+func (r *Repo) Fetch(ctx context.Context, force bool) error {
+	r.fetchMu.Lock()
+	defer r.fetchMu.Unlock()
+	if force {
+		return r.G.ForceRemoteRefresh(ctx)
+	}
+	return r.G.FetchAllFromRemote(ctx)
+}
+
+// OriginDefaultSha returns the SHA of the default branch of origin, as of the last fetch
+func (r *Repo) OriginDefaultSha(ctx context.Context) (string, error) {
+	defaultBranch, err := r.G.DefaultBranch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get default branch: %w", err)
+	}
+	return r.G.RevParse(ctx, "origin/"+defaultBranch)
+}
+
+// WithSnapshot runs f against a read only checkout of the default branch of origin.  The snapshot is moved to the
+// latest fetched SHA first.  Many callers may use the snapshot at once, but f must not modify it.
+func (r *Repo) WithSnapshot(ctx context.Context, f func(snapshot *Worktree, sha string) error) error {
+	if err := r.moveSnapshot(ctx); err != nil {
+		return err
+	}
+	r.snapshotMu.RLock()
+	defer r.snapshotMu.RUnlock()
+	return f(r.snapshot, r.snapshotSha)
+}
+
+// moveSnapshot checks the snapshot out at the SHA of the default branch of origin.  The SHA is resolved while holding
+// the write lock, so a caller that read an older SHA can never move the snapshot back after a newer one.
+func (r *Repo) moveSnapshot(ctx context.Context) error {
+	r.snapshotMu.Lock()
+	defer r.snapshotMu.Unlock()
+	sha, err := r.OriginDefaultSha(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get origin sha: %w", err)
+	}
+	if r.snapshot != nil && r.snapshotSha == sha {
+		return nil
+	}
+	if r.snapshot == nil {
+		wt, err := r.NewWorktree(ctx, "snapshot", sha)
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot worktree: %w", err)
+		}
+		r.snapshot = wt
+	} else if err := r.snapshot.G.CheckoutDetached(ctx, sha); err != nil {
+		return fmt.Errorf("failed to move snapshot to %s: %w", sha, err)
+	}
+	r.snapshotSha = sha
+	return nil
+}
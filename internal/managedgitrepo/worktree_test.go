@@ -0,0 +1,132 @@
+package managedgitrepo
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/cresta/magehelper/pipe"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func mustExec(t *testing.T, dir string, args ...string) string {
+	var stdout, stderr bytes.Buffer
+	err := pipe.NewPiped("git", args...).WithDir(dir).Execute(context.Background(), nil, &stdout, &stderr)
+	require.NoError(t, err, stderr.String())
+	return string(bytes.TrimSpace(stdout.Bytes()))
+}
+
+// commitUpstream commits version into the upstream repository, and returns the new SHA
+func commitUpstream(t *testing.T, upstream string, version string) string {
+	require.NoError(t, os.WriteFile(filepath.Join(upstream, "version"), []byte(version), 0644))
+	mustExec(t, upstream, "add", ".")
+	mustExec(t, upstream, "-c", "user.name=John Doe", "-c", "user.email=example@example.com", "commit", "-m", version)
+	return mustExec(t, upstream, "rev-parse", "HEAD")
+}
+
+func newTestRepo(t *testing.T, upstream string, diskLocation string) *Repo {
+	newGit := func(dir string) releaser.Git {
+		return &releaser.GitCli{Logger: zap.NewNop(), Dir: dir}
+	}
+	newFs := func(dir string) releaser.FileSystem {
+		return &releaser.OSFileSystem{Logger: zap.NewNop(), Root: dir}
+	}
+	r, err := NewRepo(context.Background(), diskLocation, upstream, nil, newGit, newFs)
+	require.NoError(t, err)
+	return r
+}
+
+func readVersion(t *testing.T, dir string) string {
+	b, err := os.ReadFile(filepath.Join(dir, "version"))
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestNewWorktree(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	mustExec(t, upstream, "init", "--initial-branch", "main")
+	first := commitUpstream(t, upstream, "v1")
+	commitUpstream(t, upstream, "v2")
+	diskLocation := filepath.Join(t.TempDir(), "checkout")
+	r := newTestRepo(t, upstream, diskLocation)
+
+	wt, err := r.NewWorktree(ctx, "promote/a1 prod", first)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(r.worktreeRoot(), "promote_a1_prod"), wt.Dir)
+	require.Equal(t, "v1", readVersion(t, wt.Dir))
+	require.Equal(t, "v2", readVersion(t, diskLocation))
+
+	// A worktree with the same name replaces the previous one
+	wt, err = r.NewWorktree(ctx, "promote/a1 prod", "origin/main")
+	require.NoError(t, err)
+	require.Equal(t, "v2", readVersion(t, wt.Dir))
+
+	closed, err := r.NewWorktree(ctx, "closed", first)
+	require.NoError(t, err)
+	require.NoError(t, closed.Close(ctx))
+	require.NoDirExists(t, closed.Dir)
+
+	// Restarting removes the worktrees of the previous process
+	newTestRepo(t, upstream, diskLocation)
+	require.NoDirExists(t, wt.Dir)
+	require.NotContains(t, mustExec(t, diskLocation, "worktree", "list"), "promote_a1_prod")
+}
+
+func TestWithSnapshot(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	mustExec(t, upstream, "init", "--initial-branch", "main")
+	first := commitUpstream(t, upstream, "v1")
+	r := newTestRepo(t, upstream, filepath.Join(t.TempDir(), "checkout"))
+
+	snapshotAt := func() (string, string) {
+		var sha, version string
+		require.NoError(t, r.WithSnapshot(ctx, func(snapshot *Worktree, snapshotSha string) error {
+			sha, version = snapshotSha, readVersion(t, snapshot.Dir)
+			return nil
+		}))
+		return sha, version
+	}
+	sha, version := snapshotAt()
+	require.Equal(t, first, sha)
+	require.Equal(t, "v1", version)
+
+	// The snapshot only moves once origin is fetched
+	second := commitUpstream(t, upstream, "v2")
+	sha, _ = snapshotAt()
+	require.Equal(t, first, sha)
+	require.NoError(t, r.Fetch(ctx, true))
+	sha, version = snapshotAt()
+	require.Equal(t, second, sha)
+	require.Equal(t, "v2", version)
+
+	// Readers racing a fetch see either SHA, and the snapshot ends at the newest one
+	third := commitUpstream(t, upstream, "v3")
+	var wg sync.WaitGroup
+	seen := make(chan string, 10)
+	for i := 0; i < cap(seen); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = r.WithSnapshot(ctx, func(_ *Worktree, snapshotSha string) error {
+				seen <- snapshotSha
+				return nil
+			})
+		}()
+	}
+	require.NoError(t, r.Fetch(ctx, true))
+	wg.Wait()
+	close(seen)
+	for sha := range seen {
+		require.Contains(t, []string{second, third}, sha)
+	}
+	sha, version = snapshotAt()
+	require.Equal(t, third, sha)
+	require.Equal(t, "v3", version)
+}
@@ -0,0 +1,81 @@
+package releaserserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/twitchtv/twirp"
+)
+
+// promotionPullRequest returns pullRequestID, or if it is zero the open PR that promotes application:release
+func (r *Repository) promotionPullRequest(ctx context.Context, application string, release string, pullRequestID int64) (int64, error) {
+	if pullRequestID > 0 {
+		return pullRequestID, nil
+	}
+	if application == "" && release == "" {
+		return 0, twirp.RequiredArgumentError("pull_request_id")
+	}
+	if err := requireReleaseArguments(application, release); err != nil {
+		return 0, err
+	}
+	number, err := r.Api.CheckForPRForBranch(ctx, releaser.DefaultBranchNameForRelease(application, release))
+	if err != nil {
+		return 0, fmt.Errorf("failed to find PR promoting %s:%s: %w", application, release, err)
+	}
+	if number == 0 {
+		return 0, twirp.NotFoundError(fmt.Sprintf("no open PR promotes %s:%s", application, release))
+	}
+	return number, nil
+}
+
+// pullRequestError turns the errors of approving and merging into twirp errors, whose "reason" meta tells clients why
+// the PR was refused
+func pullRequestError(err error) error {
+	var unmergeable *releaser.UnmergeableError
+	if errors.As(err, &unmergeable) {
+		return twirp.NewError(twirp.FailedPrecondition, err.Error()).WithMeta("reason", string(unmergeable.Reason))
+	}
+	if errors.Is(err, releaser.ErrPullRequestNotOpen) {
+		return twirp.NewError(twirp.FailedPrecondition, err.Error()).WithMeta("reason", string(releaser.UnmergeableNotOpen))
+	}
+	if errors.Is(err, releaser.ErrSelfApproval) {
+		return twirp.NewError(twirp.PermissionDenied, err.Error()).WithMeta("reason", "self_approval")
+	}
+	return err
+}
+
+func (s *Server) ApprovePromotion(ctx context.Context, request *releaser_protobuf.ApprovePromotionRequest) (*releaser_protobuf.ApprovePromotionResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if request.Approver == "" {
+		return nil, twirp.RequiredArgumentError("approver")
+	}
+	number, err := r.promotionPullRequest(ctx, request.ApplicationName, request.ReleaseName, request.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Api.ApprovePullRequestAs(ctx, request.Approver, request.Message, number); err != nil {
+		return nil, pullRequestError(fmt.Errorf("failed to approve PR %d: %w", number, err))
+	}
+	return &releaser_protobuf.ApprovePromotionResponse{PullRequestId: number}, nil
+}
+
+func (s *Server) MergePromotion(ctx context.Context, request *releaser_protobuf.MergePromotionRequest) (*releaser_protobuf.MergePromotionResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	number, err := r.promotionPullRequest(ctx, request.ApplicationName, request.ReleaseName, request.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Api.MergePullRequestForCurrentRemote(ctx, number); err != nil {
+		return nil, pullRequestError(fmt.Errorf("failed to merge PR %d: %w", number, err))
+	}
+	return &releaser_protobuf.MergePromotionResponse{PullRequestId: number}, nil
+}
@@ -0,0 +1,39 @@
+package releaserserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+)
+
+func TestPullRequestError(t *testing.T) {
+	requireTwirp := func(err error, code twirp.ErrorCode, reason string) {
+		var terr twirp.Error
+		require.True(t, errors.As(err, &terr))
+		require.Equal(t, code, terr.Code())
+		require.Equal(t, reason, terr.Meta("reason"))
+	}
+	details := &releaser.PullRequestDetails{Number: 7, State: releaser.PullRequestStateOpen, Mergeable: releaser.MergeableStateConflicting}
+	requireTwirp(pullRequestError(fmt.Errorf("failed to merge PR 7: %w", releaser.CheckMergeable(details))), twirp.FailedPrecondition, "conflicting")
+	requireTwirp(pullRequestError(fmt.Errorf("cannot approve PR 7: %w", releaser.ErrPullRequestNotOpen)), twirp.FailedPrecondition, "not_open")
+	requireTwirp(pullRequestError(fmt.Errorf("bob cannot approve PR 7: %w", releaser.ErrSelfApproval)), twirp.PermissionDenied, "self_approval")
+	other := errors.New("network is down")
+	require.Equal(t, other, pullRequestError(other))
+}
+
+func TestPromotionPullRequest(t *testing.T) {
+	ctx := context.Background()
+	r := &Repository{}
+	number, err := r.promotionPullRequest(ctx, "", "", 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), number)
+	_, err = r.promotionPullRequest(ctx, "", "", 0)
+	require.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = r.promotionPullRequest(ctx, "app", "", 0)
+	require.Equal(t, "release_name", err.(twirp.Error).Meta("argument"))
+}
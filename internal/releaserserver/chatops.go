@@ -0,0 +1,166 @@
+package releaserserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"go.uber.org/zap"
+)
+
+// chatOpsPrefix starts every command reviewers can comment on a PR
+const chatOpsPrefix = "/releaser"
+
+const (
+	// chatOpsRebase regenerates the promotion from the current default branch
+	chatOpsRebase = "rebase"
+	// chatOpsMerge merges the PR, if its checks are not failing
+	chatOpsMerge = "merge"
+	// chatOpsHold converts the PR to a draft so it cannot be merged
+	chatOpsHold = "hold"
+	// chatOpsUnhold marks a held PR ready for review again
+	chatOpsUnhold = "unhold"
+	// chatOpsPromoteNext opens the PR that promotes a merged promotion into the release after it
+	chatOpsPromoteNext = "promote-next"
+)
+
+const chatOpsUsage = "usage: `/releaser rebase|merge|hold|unhold|promote-next`"
+
+// parseChatOpsCommand returns the command of the first line of a comment that starts with /releaser.  The command is
+// empty if the line has nothing after /releaser.
+func parseChatOpsCommand(body string) (string, bool) {
+	s := bufio.NewScanner(strings.NewReader(body))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || fields[0] != chatOpsPrefix {
+			continue
+		}
+		if len(fields) == 1 {
+			return "", true
+		}
+		return strings.ToLower(fields[1]), true
+	}
+	return "", false
+}
+
+// runChatOps runs a command commented on a PR by login, and replies with the result on the PR
+func (r *Repository) runChatOps(ctx context.Context, prNumber int64, login string, command string) error {
+	gh, ok := r.Repo.Host.(releaser.GitHub)
+	if !ok || r.remote == nil {
+		return fmt.Errorf("comment commands are only supported on GitHub")
+	}
+	permission, err := gh.UserPermission(ctx, r.remote.Owner, r.remote.Name, login)
+	if err != nil {
+		return fmt.Errorf("failed to get permission of %s: %w", login, err)
+	}
+	var reply string
+	if !permission.CanWrite() {
+		reply = fmt.Sprintf("@%s you need write access to this repository to run `%s %s`", login, chatOpsPrefix, command)
+	} else if result, err := r.chatOpsCommand(ctx, gh, prNumber, login, command); err != nil {
+		r.Logger.Warn("comment command failed", zap.Int64("pr", prNumber), zap.String("command", command), zap.Error(err))
+		reply = fmt.Sprintf("@%s `%s %s` failed: %s", login, chatOpsPrefix, command, err)
+	} else {
+		reply = fmt.Sprintf("@%s `%s %s`: %s", login, chatOpsPrefix, command, result)
+	}
+	if err := gh.CommentOnPullRequest(ctx, r.remote.Owner, r.remote.Name, prNumber, reply); err != nil {
+		return fmt.Errorf("failed to reply to PR %d: %w", prNumber, err)
+	}
+	return nil
+}
+
+// chatOpsCommand runs a command on a PR and describes what it did
+func (r *Repository) chatOpsCommand(ctx context.Context, gh releaser.GitHub, prNumber int64, login string, command string) (string, error) {
+	switch command {
+	case chatOpsHold, chatOpsUnhold:
+		hold := command == chatOpsHold
+		if err := gh.SetPullRequestHold(ctx, r.remote.Owner, r.remote.Name, prNumber, hold); err != nil {
+			return "", err
+		}
+		if hold {
+			return "converted to a draft until `/releaser unhold`", nil
+		}
+		return "marked ready for review", nil
+	case chatOpsMerge:
+		if err := r.Api.MergePullRequestForCurrentRemote(ctx, prNumber); err != nil {
+			return "", err
+		}
+		return "merged", nil
+	case chatOpsRebase, chatOpsPromoteNext:
+	default:
+		return "", fmt.Errorf("unknown command %q, %s", command, chatOpsUsage)
+	}
+	pr, err := gh.MergedPullRequest(ctx, r.remote.Owner, r.remote.Name, prNumber)
+	if err != nil {
+		return "", err
+	}
+	promotion, ok := releaser.ParsePromotion(pr.HeadMessage)
+	if !ok {
+		return "", fmt.Errorf("PR %d is not a promotion", prNumber)
+	}
+	release := promotion.To
+	if command == chatOpsRebase {
+		if pr.Merged {
+			return "", fmt.Errorf("PR %d is already merged", prNumber)
+		}
+	} else {
+		if !pr.Merged {
+			return "", fmt.Errorf("PR %d must be merged before promoting the next release", prNumber)
+		}
+		release, err = r.nextRelease(ctx, promotion.Application, promotion.To)
+		if err != nil {
+			return "", err
+		}
+	}
+	resp, err := r.pushPromotion(ctx, &releaser_protobuf.PushPromotionRequest{
+		ApplicationName: promotion.Application,
+		ReleaseName:     release,
+		Actor:           login,
+	})
+	if err != nil {
+		return "", err
+	}
+	return describePushPromotion(promotion.Application, release, resp), nil
+}
+
+// nextRelease returns the release that promotes from release
+func (r *Repository) nextRelease(ctx context.Context, application string, release string) (string, error) {
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return "", fmt.Errorf("failed to fetch from origin: %w", err)
+	}
+	var next string
+	err := r.Repo.WithSnapshot(ctx, func(snapshot *managedgitrepo.Worktree, _ string) error {
+		releases, err := r.apiFor(snapshot).ListReleases(application)
+		if err != nil {
+			return fmt.Errorf("failed to list releases of %s: %w", application, err)
+		}
+		for i, name := range releases {
+			if name == release && i+1 < len(releases) {
+				next = releases[i+1]
+				return nil
+			}
+		}
+		return fmt.Errorf("%s:%s is the last release", application, release)
+	})
+	return next, err
+}
+
+func describePushPromotion(application string, release string, resp *releaser_protobuf.PushPromotionResponse) string {
+	switch resp.Status {
+	case releaser_protobuf.PushPromotionResponse_NEW_PULL_REQUEST:
+		return fmt.Sprintf("opened #%d to promote %s:%s", resp.PullRequestId, application, release)
+	case releaser_protobuf.PushPromotionResponse_UPDATED_PULL_REQUEST:
+		return fmt.Sprintf("updated #%d from the default branch", resp.PullRequestId)
+	case releaser_protobuf.PushPromotionResponse_EXISTING_PULL_REQUEST:
+		return fmt.Sprintf("#%d is already up to date", resp.PullRequestId)
+	case releaser_protobuf.PushPromotionResponse_DIRECT_COMMIT:
+		return fmt.Sprintf("committed %s to promote %s:%s", resp.CommitSha, application, release)
+	case releaser_protobuf.PushPromotionResponse_NO_CHANGES:
+		return fmt.Sprintf("%s:%s has nothing to promote", application, release)
+	default:
+		return fmt.Sprintf("promoted %s:%s", application, release)
+	}
+}
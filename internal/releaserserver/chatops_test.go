@@ -0,0 +1,81 @@
+package releaserserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// chatOpsRecorder is a GitHub that records the comments and holds of comment commands
+type chatOpsRecorder struct {
+	releaser.GitHub
+	permission releaser.RepositoryPermission
+	comments   []string
+	holds      []bool
+}
+
+func (c *chatOpsRecorder) UserPermission(_ context.Context, _ string, _ string, _ string) (releaser.RepositoryPermission, error) {
+	return c.permission, nil
+}
+
+func (c *chatOpsRecorder) CommentOnPullRequest(_ context.Context, _ string, _ string, _ int64, body string) error {
+	c.comments = append(c.comments, body)
+	return nil
+}
+
+func (c *chatOpsRecorder) SetPullRequestHold(_ context.Context, _ string, _ string, _ int64, hold bool) error {
+	c.holds = append(c.holds, hold)
+	return nil
+}
+
+func TestParseChatOpsCommand(t *testing.T) {
+	for _, tc := range []struct {
+		body    string
+		command string
+		ok      bool
+	}{
+		{body: "/releaser rebase", command: "rebase", ok: true},
+		{body: "LGTM\r\n/releaser Merge please\r\n", command: "merge", ok: true},
+		{body: "  /releaser   promote-next", command: "promote-next", ok: true},
+		{body: "/releaser", command: "", ok: true},
+		{body: "/releaserhold", ok: false},
+		{body: "please /releaser hold", ok: false},
+		{body: "LGTM", ok: false},
+	} {
+		command, ok := parseChatOpsCommand(tc.body)
+		require.Equal(t, tc.ok, ok, tc.body)
+		require.Equal(t, tc.command, command, tc.body)
+	}
+}
+
+func TestRunChatOps(t *testing.T) {
+	gh := &chatOpsRecorder{permission: releaser.RepositoryPermissionRead}
+	repo := &Repository{
+		ID:     "deploy",
+		Logger: zap.NewNop(),
+		Repo:   &managedgitrepo.Repo{Host: gh},
+		remote: &releaser.RemoteURL{Host: "github.com", Owner: "cresta", Name: "deploy"},
+	}
+	ctx := context.Background()
+	require.NoError(t, repo.runChatOps(ctx, 42, "octocat", "hold"))
+	require.Empty(t, gh.holds)
+	require.Equal(t, []string{"@octocat you need write access to this repository to run `/releaser hold`"}, gh.comments)
+
+	gh.permission, gh.comments = releaser.RepositoryPermissionMaintain, nil
+	require.NoError(t, repo.runChatOps(ctx, 42, "octocat", "hold"))
+	require.NoError(t, repo.runChatOps(ctx, 42, "octocat", "unhold"))
+	require.Equal(t, []bool{true, false}, gh.holds)
+	require.Equal(t, []string{
+		"@octocat `/releaser hold`: converted to a draft until `/releaser unhold`",
+		"@octocat `/releaser unhold`: marked ready for review",
+	}, gh.comments)
+
+	gh.comments = nil
+	require.NoError(t, repo.runChatOps(ctx, 42, "octocat", "deploy"))
+	require.Len(t, gh.comments, 1)
+	require.Contains(t, gh.comments[0], "`/releaser deploy` failed: unknown command")
+}
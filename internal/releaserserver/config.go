@@ -0,0 +1,188 @@
+package releaserserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the on disk configuration of a releaser server
+type Config struct {
+	Repositories []RepositoryConfig `yaml:"repositories"`
+}
+
+// RepositoryConfig describes a single git repository managed by the server
+type RepositoryConfig struct {
+	// ID is how RPC requests refer to this repository
+	ID string `yaml:"id"`
+	// URL is the clone URL of the repository
+	URL string `yaml:"url"`
+	// DiskLocation is where the repository is checked out.  Defaults to a directory named after ID inside the
+	// server's repository root.
+	DiskLocation string `yaml:"diskLocation"`
+	// DefaultBranch overrides the branch releases are cut from.  Defaults to the default branch of the remote.
+	DefaultBranch string `yaml:"defaultBranch"`
+	// Provider is the code host of the repository: github or gitlab.  Defaults to guessing from the URL.
+	Provider string `yaml:"provider"`
+	// Github overrides the default (environment based) GitHub credentials for this repository
+	Github GithubConfig `yaml:"github"`
+	// Gitlab overrides the default (environment based) GitLab instance and credentials for this repository
+	Gitlab GitlabConfig `yaml:"gitlab"`
+	// Signing controls how promotion commits are signed
+	Signing SigningConfig `yaml:"signing"`
+	// CommitMessageTemplate overrides the go template promotion commit messages are rendered with.  Trailers that
+	// describe the promotion are always added.
+	CommitMessageTemplate string `yaml:"commitMessageTemplate"`
+	// Fetch limits how much of the repository is cloned and checked out
+	Fetch FetchConfig `yaml:"fetch"`
+	// ReconcilePullRequests closes obsolete promotion pull requests every time the repository is refreshed
+	ReconcilePullRequests bool `yaml:"reconcilePullRequests"`
+	// Deployments creates a GitHub deployment whenever a promotion pull request is merged, as reported by webhooks
+	Deployments bool `yaml:"deployments"`
+}
+
+// FetchConfig configures partial clones and sparse checkouts, for repositories that are mostly unrelated to releases
+type FetchConfig struct {
+	// Filter is a partial clone filter, such as blob:none.  It only applies when the repository is first cloned.
+	Filter string `yaml:"filter"`
+	// Sparse checks out only the apps directory and .releaser.yaml files
+	Sparse bool `yaml:"sparse"`
+	// SparsePaths overrides the gitignore style patterns that are checked out when Sparse is set
+	SparsePaths []string `yaml:"sparsePaths"`
+}
+
+// SigningConfig signs promotion commits either with a local key, or by creating them through the GitHub API
+type SigningConfig struct {
+	// Format is the git signature format (openpgp, x509 or ssh) of Key
+	Format string `yaml:"format"`
+	// Key is the GPG key ID, or SSH key path, promotion commits are signed with
+	Key string `yaml:"key"`
+	// ThroughGithub creates promotion commits with the GitHub API, which signs them as the GitHub App or token owner
+	ThroughGithub bool `yaml:"throughGithub"`
+}
+
+type GithubConfig struct {
+	AppID          int64  `yaml:"appId"`
+	InstallationID int64  `yaml:"installationId"`
+	PEMKeyLoc      string `yaml:"pemKeyLoc"`
+	// BaseURL is the root of a GitHub Enterprise Server instance, such as https://github.example.com.  Defaults to
+	// github.com, or the host of the repository url.
+	BaseURL string `yaml:"baseUrl"`
+	// TokenEnv is the name of an environment variable that holds a GitHub token
+	TokenEnv string `yaml:"tokenEnv"`
+}
+
+// LoadConfig reads a Config from a YAML file.  Repositories without a disk location are placed inside repoRoot.
+func LoadConfig(path string, repoRoot string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config file %s: %w", path, err)
+	}
+	var ret Config
+	if err := yaml.UnmarshalStrict(b, &ret); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	for i := range ret.Repositories {
+		if ret.Repositories[i].DiskLocation == "" {
+			ret.Repositories[i].DiskLocation = filepath.Join(repoRoot, ret.Repositories[i].ID)
+		}
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return &ret, nil
+}
+
+// SingleRepositoryConfig is the config of a server that only manages one repository
+func SingleRepositoryConfig(repository RepositoryConfig) *Config {
+	return &Config{
+		Repositories: []RepositoryConfig{repository},
+	}
+}
+
+func (c *Config) Validate() error {
+	if len(c.Repositories) == 0 {
+		return fmt.Errorf("no repositories configured")
+	}
+	seenIDs := make(map[string]struct{}, len(c.Repositories))
+	seenLocations := make(map[string]struct{}, len(c.Repositories))
+	for _, r := range c.Repositories {
+		if r.ID == "" {
+			return fmt.Errorf("repository %s has no id", r.URL)
+		}
+		if r.URL == "" {
+			return fmt.Errorf("repository %s has no url", r.ID)
+		}
+		if _, exists := seenIDs[r.ID]; exists {
+			return fmt.Errorf("duplicate repository id %s", r.ID)
+		}
+		seenIDs[r.ID] = struct{}{}
+		loc := filepath.Clean(r.DiskLocation)
+		if _, exists := seenLocations[loc]; exists {
+			return fmt.Errorf("repository %s shares disk location %s with another repository", r.ID, r.DiskLocation)
+		}
+		seenLocations[loc] = struct{}{}
+		switch r.Provider {
+		case "", releaser.ProviderGithub, releaser.ProviderGitlab:
+		default:
+			return fmt.Errorf("repository %s has unknown provider %s", r.ID, r.Provider)
+		}
+		if r.Signing.ThroughGithub && r.Provider == releaser.ProviderGitlab {
+			return fmt.Errorf("repository %s cannot create commits through GitHub on GitLab", r.ID)
+		}
+		if r.Deployments && r.Provider == releaser.ProviderGitlab {
+			return fmt.Errorf("repository %s cannot create GitHub deployments on GitLab", r.ID)
+		}
+		if r.Signing.ThroughGithub && (r.Signing.Key != "" || r.Signing.Format != "") {
+			return fmt.Errorf("repository %s cannot both sign commits with a key and through GitHub", r.ID)
+		}
+	}
+	return nil
+}
+
+// sparseCheckout returns the sparse checkout patterns, or nil to check out every file
+func (f FetchConfig) sparseCheckout() []string {
+	if !f.Sparse {
+		return nil
+	}
+	if len(f.SparsePaths) > 0 {
+		return f.SparsePaths
+	}
+	return releaser.DefaultSparseCheckout
+}
+
+// commitSigning returns how GitCli should sign commits, or nil to leave it up to the git config
+func (s SigningConfig) commitSigning() *releaser.CommitSigning {
+	if s.Key == "" && s.Format == "" {
+		return nil
+	}
+	return &releaser.CommitSigning{
+		Format: s.Format,
+		Key:    s.Key,
+	}
+}
+
+type GitlabConfig struct {
+	// BaseURL is the root of the GitLab instance, such as https://gitlab.example.com
+	BaseURL string `yaml:"baseUrl"`
+	// TokenEnv is the name of an environment variable that holds a GitLab token
+	TokenEnv string `yaml:"tokenEnv"`
+}
+
+func (g GitlabConfig) token() string {
+	if g.TokenEnv == "" {
+		return ""
+	}
+	return os.Getenv(g.TokenEnv)
+}
+
+func (g GithubConfig) token() string {
+	if g.TokenEnv == "" {
+		return ""
+	}
+	return os.Getenv(g.TokenEnv)
+}
@@ -0,0 +1,94 @@
+package releaserserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	root := t.TempDir()
+	load := func(t *testing.T, contents string) (*Config, error) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+		return LoadConfig(path, root)
+	}
+	t.Run("defaults disk location", func(t *testing.T) {
+		c, err := load(t, `
+repositories:
+  - id: deploy
+    url: https://github.com/cresta/deploy.git
+  - id: infra
+    url: https://github.com/cresta/infra.git
+    diskLocation: /var/infra
+`)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(root, "deploy"), c.Repositories[0].DiskLocation)
+		require.Equal(t, "/var/infra", c.Repositories[1].DiskLocation)
+	})
+	t.Run("rejects unknown keys", func(t *testing.T) {
+		_, err := load(t, `
+repositories:
+  - id: deploy
+    url: https://github.com/cresta/deploy.git
+    defaultBrnach: main
+`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "defaultBrnach")
+	})
+	t.Run("validates", func(t *testing.T) {
+		_, err := load(t, `
+repositories:
+  - id: deploy
+`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "deploy has no url")
+	})
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadConfig(filepath.Join(root, "missing.yaml"), root)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	repo := func(id string, modify ...func(r *RepositoryConfig)) RepositoryConfig {
+		r := RepositoryConfig{ID: id, URL: "https://github.com/cresta/" + id + ".git", DiskLocation: "/repos/" + id}
+		for _, m := range modify {
+			m(&r)
+		}
+		return r
+	}
+	testCases := []struct {
+		name         string
+		repositories []RepositoryConfig
+		wantErr      string
+	}{
+		{name: "valid", repositories: []RepositoryConfig{repo("deploy"), repo("infra")}},
+		{name: "empty", wantErr: "no repositories configured"},
+		{name: "missing id", repositories: []RepositoryConfig{repo("")}, wantErr: "has no id"},
+		{name: "missing url", repositories: []RepositoryConfig{repo("deploy", func(r *RepositoryConfig) { r.URL = "" })}, wantErr: "deploy has no url"},
+		{name: "duplicate id", repositories: []RepositoryConfig{repo("deploy"), repo("deploy", func(r *RepositoryConfig) { r.DiskLocation = "/other" })}, wantErr: "duplicate repository id deploy"},
+		{name: "shared disk location", repositories: []RepositoryConfig{repo("deploy"), repo("infra", func(r *RepositoryConfig) { r.DiskLocation = "/repos/deploy/" })}, wantErr: "shares disk location"},
+		{name: "unknown provider", repositories: []RepositoryConfig{repo("deploy", func(r *RepositoryConfig) { r.Provider = "bitbucket" })}, wantErr: "unknown provider bitbucket"},
+		{name: "deployments on gitlab", repositories: []RepositoryConfig{repo("deploy", func(r *RepositoryConfig) {
+			r.Provider = "gitlab"
+			r.Deployments = true
+		})}, wantErr: "cannot create GitHub deployments"},
+		{name: "two kinds of signing", repositories: []RepositoryConfig{repo("deploy", func(r *RepositoryConfig) {
+			r.Signing = SigningConfig{ThroughGithub: true, Key: "ABC"}
+		})}, wantErr: "cannot both sign"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&Config{Repositories: tc.repositories}).Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package releaserserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+)
+
+func (s *Server) ListDeployments(ctx context.Context, request *releaser_protobuf.ListDeploymentsRequest) (*releaser_protobuf.ListDeploymentsResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if request.ApplicationName == "" {
+		return nil, twirp.RequiredArgumentError("application_name")
+	}
+	if request.ReleaseName == "" {
+		return nil, twirp.RequiredArgumentError("release_name")
+	}
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return nil, fmt.Errorf("failed to fetch from origin: %w", err)
+	}
+	var deployments []releaser.Deployment
+	if err := r.Repo.WithSnapshot(ctx, func(snapshot *managedgitrepo.Worktree, _ string) error {
+		var err error
+		deployments, err = r.apiFor(snapshot).Deployments(ctx, request.ApplicationName, request.ReleaseName)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list deployments of %s:%s: %w", request.ApplicationName, request.ReleaseName, err)
+	}
+	var ret releaser_protobuf.ListDeploymentsResponse
+	for _, d := range deployments {
+		ret.Deployments = append(ret.Deployments, &releaser_protobuf.Deployment{
+			Id:              d.ID,
+			Sha:             d.Sha,
+			Environment:     d.Environment,
+			ApplicationName: d.Payload.Application,
+			ReleaseName:     d.Payload.Release,
+			FromReleaseName: d.Payload.From,
+			OriginalGitSha:  d.Payload.OriginalSha,
+			PullRequestId:   d.Payload.PullRequest,
+			State:           deploymentStateAsProto(d.State),
+			CreatedAt:       d.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return &ret, nil
+}
+
+func (s *Server) SetDeploymentStatus(ctx context.Context, request *releaser_protobuf.SetDeploymentStatusRequest) (*releaser_protobuf.SetDeploymentStatusResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if request.DeploymentId <= 0 {
+		return nil, twirp.RequiredArgumentError("deployment_id")
+	}
+	state, ok := deploymentStateFromProto(request.State)
+	if !ok {
+		return nil, twirp.InvalidArgumentError("state", "must be set")
+	}
+	if err := r.Api.SetDeploymentStatus(ctx, request.DeploymentId, state, request.Description); err != nil {
+		return nil, fmt.Errorf("failed to set status of deployment %d: %w", request.DeploymentId, err)
+	}
+	return &releaser_protobuf.SetDeploymentStatusResponse{}, nil
+}
+
+// deployPullRequest creates the GitHub deployment of a merged promotion PR, reading release config from the current
+// snapshot of the default branch
+func (r *Repository) deployPullRequest(ctx context.Context, prNumber int64) error {
+	return r.Repo.WithSnapshot(ctx, func(snapshot *managedgitrepo.Worktree, _ string) error {
+		deployment, err := r.apiFor(snapshot).DeployPullRequest(ctx, prNumber)
+		if err != nil {
+			return err
+		}
+		r.Logger.Info("created deployment", zap.Int64("pr", prNumber), zap.Int64("deployment", deployment.ID), zap.String("environment", deployment.Environment))
+		return nil
+	})
+}
+
+var deploymentStates = []struct {
+	state releaser.DeploymentState
+	proto releaser_protobuf.Deployment_State
+}{
+	{releaser.DeploymentStateQueued, releaser_protobuf.Deployment_STATE_QUEUED},
+	{releaser.DeploymentStatePending, releaser_protobuf.Deployment_STATE_PENDING},
+	{releaser.DeploymentStateInProgress, releaser_protobuf.Deployment_STATE_IN_PROGRESS},
+	{releaser.DeploymentStateSuccess, releaser_protobuf.Deployment_STATE_SUCCESS},
+	{releaser.DeploymentStateFailure, releaser_protobuf.Deployment_STATE_FAILURE},
+	{releaser.DeploymentStateError, releaser_protobuf.Deployment_STATE_ERROR},
+	{releaser.DeploymentStateInactive, releaser_protobuf.Deployment_STATE_INACTIVE},
+}
+
+func deploymentStateAsProto(state releaser.DeploymentState) releaser_protobuf.Deployment_State {
+	for _, s := range deploymentStates {
+		if s.state == state {
+			return s.proto
+		}
+	}
+	return releaser_protobuf.Deployment_STATE_UNKNOWN
+}
+
+func deploymentStateFromProto(state releaser_protobuf.Deployment_State) (releaser.DeploymentState, bool) {
+	for _, s := range deploymentStates {
+		if s.proto == state {
+			return s.state, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,333 @@
+package releaserserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxStatusEvents is how many events are remembered for clients that reconnect
+	maxStatusEvents = 1000
+	// eventKeepAlive is how often an idle stream gets a comment, so proxies do not close it
+	eventKeepAlive = 15 * time.Second
+)
+
+// statusEvents remembers recent changes to release status, so clients that reconnect with the ID of the last event
+// they saw get the events they missed
+type statusEvents struct {
+	// epoch tells apart the events of different server processes, whose sequence numbers all start at one
+	epoch string
+	// poke asks WatchStatus to look for changes right away
+	poke chan struct{}
+	// closed ends every stream when the server shuts down
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu sync.Mutex
+	// events holds the most recent events, oldest first.  The last one has sequence number seq.
+	events []*releaser_protobuf.StatusEvent
+	seq    int64
+	// published is closed, and replaced, whenever events are published
+	published chan struct{}
+	// previous is the status of each repository as of the last events
+	previous map[string][]*releaser_protobuf.ApplicationStatus
+}
+
+func newStatusEvents() *statusEvents {
+	return &statusEvents{
+		epoch:     strconv.FormatInt(time.Now().UnixNano(), 36),
+		poke:      make(chan struct{}, 1),
+		closed:    make(chan struct{}),
+		published: make(chan struct{}),
+		previous:  make(map[string][]*releaser_protobuf.ApplicationStatus),
+	}
+}
+
+func (e *statusEvents) token(seq int64) string {
+	return fmt.Sprintf("%s-%d", e.epoch, seq)
+}
+
+// parseToken returns the sequence number of a token from this process
+func (e *statusEvents) parseToken(token string) (int64, bool) {
+	idx := strings.LastIndex(token, "-")
+	if idx == -1 || token[:idx] != e.epoch {
+		return 0, false
+	}
+	seq, err := strconv.ParseInt(token[idx+1:], 10, 64)
+	return seq, err == nil
+}
+
+// refresh asks WatchStatus to look for changes without waiting for its interval.  It is safe to call on a nil
+// statusEvents.
+func (e *statusEvents) refresh() {
+	if e == nil {
+		return
+	}
+	select {
+	case e.poke <- struct{}{}:
+	default:
+	}
+}
+
+// update records the status of a repository, and publishes how it changed since the last update.  The first update of
+// a repository publishes nothing.
+func (e *statusEvents) update(repository string, status []*releaser_protobuf.ApplicationStatus, closedState func(number int64) releaser_protobuf.PullRequestStatus_State) {
+	e.mu.Lock()
+	prev, seen := e.previous[repository]
+	e.previous[repository] = status
+	e.mu.Unlock()
+	if !seen {
+		return
+	}
+	e.publish(statusChanges(repository, prev, status, closedState))
+}
+
+// close ends every stream, and stops new ones from starting
+func (e *statusEvents) close() {
+	e.closeOnce.Do(func() {
+		close(e.closed)
+	})
+}
+
+func (e *statusEvents) publish(events []*releaser_protobuf.StatusEvent) {
+	if len(events) == 0 {
+		return
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, ev := range events {
+		e.seq++
+		ev.Id = e.token(e.seq)
+		ev.Time = now
+		e.events = append(e.events, ev)
+	}
+	if len(e.events) > maxStatusEvents {
+		e.events = append([]*releaser_protobuf.StatusEvent(nil), e.events[len(e.events)-maxStatusEvents:]...)
+	}
+	close(e.published)
+	e.published = make(chan struct{})
+}
+
+// since returns the events after the one token names, and a channel that is closed when more are published.  If the
+// events after token are not all remembered, a reset event is returned instead.
+func (e *statusEvents) since(token string) ([]*releaser_protobuf.StatusEvent, <-chan struct{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	first := e.seq - int64(len(e.events)) + 1
+	after, ok := e.parseToken(token)
+	if !ok || after < first-1 || after > e.seq {
+		return []*releaser_protobuf.StatusEvent{{
+			Id:   e.token(e.seq),
+			Type: releaser_protobuf.StatusEvent_TYPE_RESET,
+			Time: time.Now().UTC().Format(time.RFC3339),
+		}}, e.published
+	}
+	return append([]*releaser_protobuf.StatusEvent(nil), e.events[after-first+1:]...), e.published
+}
+
+// statusChanges returns the events that turn prev into cur.  closedState looks up what became of a PR that no longer
+// belongs to its release.
+func statusChanges(repository string, prev []*releaser_protobuf.ApplicationStatus, cur []*releaser_protobuf.ApplicationStatus, closedState func(number int64) releaser_protobuf.PullRequestStatus_State) []*releaser_protobuf.StatusEvent {
+	type releaseKey struct {
+		application string
+		release     string
+	}
+	previous := make(map[releaseKey]*releaser_protobuf.ReleaseStatus)
+	for _, app := range prev {
+		for _, rs := range app.ReleaseStatus {
+			previous[releaseKey{app.Name, rs.Name}] = rs
+		}
+	}
+	var ret []*releaser_protobuf.StatusEvent
+	event := func(eventType releaser_protobuf.StatusEvent_Type, application string, p *releaser_protobuf.ReleaseStatus, c *releaser_protobuf.ReleaseStatus, pr int64) {
+		ev := &releaser_protobuf.StatusEvent{
+			Type:                  eventType,
+			Repository:            repository,
+			ApplicationName:       application,
+			ReleaseStatus:         c,
+			PreviousReleaseStatus: p,
+			PullRequestId:         pr,
+		}
+		if c != nil {
+			ev.ReleaseName = c.Name
+		} else {
+			ev.ReleaseName = p.Name
+		}
+		ret = append(ret, ev)
+	}
+	for _, app := range cur {
+		for _, c := range app.ReleaseStatus {
+			key := releaseKey{app.Name, c.Name}
+			p, exists := previous[key]
+			delete(previous, key)
+			if !exists {
+				event(releaser_protobuf.StatusEvent_TYPE_RELEASE_ADDED, app.Name, nil, c, c.PrNumber)
+				continue
+			}
+			if p.Status != c.Status {
+				switch c.Status {
+				case releaser_protobuf.ReleaseStatus_PENDING:
+					event(releaser_protobuf.StatusEvent_TYPE_RELEASE_PENDING, app.Name, p, c, c.PrNumber)
+				case releaser_protobuf.ReleaseStatus_RELEASED:
+					event(releaser_protobuf.StatusEvent_TYPE_RELEASE_RELEASED, app.Name, p, c, c.PrNumber)
+				}
+			}
+			if p.PrNumber != c.PrNumber {
+				if p.PrNumber != 0 {
+					closed := releaser_protobuf.StatusEvent_TYPE_PULL_REQUEST_CLOSED
+					if closedState(p.PrNumber) == releaser_protobuf.PullRequestStatus_STATE_MERGED {
+						closed = releaser_protobuf.StatusEvent_TYPE_PULL_REQUEST_MERGED
+					}
+					event(closed, app.Name, p, c, p.PrNumber)
+				}
+				if c.PrNumber != 0 {
+					event(releaser_protobuf.StatusEvent_TYPE_PULL_REQUEST_OPENED, app.Name, p, c, c.PrNumber)
+				}
+				continue
+			}
+			if c.PrNumber == 0 || p.PullRequest == nil || c.PullRequest == nil {
+				continue
+			}
+			if p.PullRequest.Draft != c.PullRequest.Draft {
+				hold := releaser_protobuf.StatusEvent_TYPE_HOLD_REMOVED
+				if c.PullRequest.Draft {
+					hold = releaser_protobuf.StatusEvent_TYPE_HOLD_ADDED
+				}
+				event(hold, app.Name, p, c, c.PrNumber)
+			}
+			withoutDraft := proto.Clone(p.PullRequest).(*releaser_protobuf.PullRequestStatus)
+			withoutDraft.Draft = c.PullRequest.Draft
+			if !proto.Equal(withoutDraft, c.PullRequest) {
+				event(releaser_protobuf.StatusEvent_TYPE_PULL_REQUEST_UPDATED, app.Name, p, c, c.PrNumber)
+			}
+		}
+	}
+	for _, app := range prev {
+		for _, p := range app.ReleaseStatus {
+			if _, removed := previous[releaseKey{app.Name, p.Name}]; removed {
+				event(releaser_protobuf.StatusEvent_TYPE_RELEASE_REMOVED, app.Name, p, nil, p.PrNumber)
+			}
+		}
+	}
+	return ret
+}
+
+// WatchStatus looks for changes to release status every interval, and whenever a webhook may have changed it, until
+// ctx ends.  The changes are streamed by ServeEvents.
+func WatchStatus(ctx context.Context, s *Server, interval time.Duration) {
+	if interval == 0 {
+		s.Logger.Info("WatchStatus disabled")
+		return
+	}
+	go func() {
+		for {
+			for _, rid := range s.repositoryOrder {
+				s.repositories[rid].watchStatus(ctx, s.events)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			case <-s.events.poke:
+			}
+		}
+	}()
+}
+
+func (r *Repository) watchStatus(ctx context.Context, events *statusEvents) {
+	status, _, err := r.applicationStatus(ctx)
+	if err != nil {
+		r.Logger.Warn("failed to get application status for events", zap.Error(err))
+		return
+	}
+	events.update(r.ID, status, func(number int64) releaser_protobuf.PullRequestStatus_State {
+		details, err := r.Api.PullRequestDetails(ctx, number)
+		if err != nil {
+			r.Logger.Warn("failed to get details of closed PR", zap.Int64("pr", number), zap.Error(err))
+			return releaser_protobuf.PullRequestStatus_STATE_UNKNOWN
+		}
+		return pullRequestAsProto(details).State
+	})
+}
+
+// ServeEvents streams changes to release status as server-sent events.  A client that reconnects with the ID of the
+// last event it saw, as the Last-Event-ID header or the last_event_id parameter, gets the events it missed.  If those
+// are no longer remembered, or the client did not send an ID, the stream starts with a reset event.  The repository
+// parameter limits the stream to one repository.
+func (s *Server) ServeEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	select {
+	case <-s.events.closed:
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	default:
+	}
+	token := r.Header.Get("Last-Event-ID")
+	if token == "" {
+		token = r.URL.Query().Get("last_event_id")
+	}
+	repository := r.URL.Query().Get("repository")
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Stop nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		events, published := s.events.since(token)
+		for _, ev := range events {
+			token = ev.Id
+			if repository != "" && ev.Type != releaser_protobuf.StatusEvent_TYPE_RESET && ev.Repository != repository {
+				continue
+			}
+			if err := writeEvent(w, ev); err != nil {
+				s.Logger.Debug("event stream closed", zap.Error(err))
+				return
+			}
+		}
+		flusher.Flush()
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.events.closed:
+			return
+		case <-published:
+		case <-time.After(eventKeepAlive):
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// CloseEvents ends every stream served by ServeEvents.  http.Server.Shutdown waits for requests to finish, and streams
+// never finish on their own, so it must be registered with http.Server.RegisterOnShutdown.
+func (s *Server) CloseEvents() {
+	s.events.close()
+}
+
+func writeEvent(w http.ResponseWriter, ev *releaser_protobuf.StatusEvent) error {
+	data, err := protojson.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	name := strings.ToLower(strings.TrimPrefix(ev.Type.String(), "TYPE_"))
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.Id, name, data)
+	return err
+}
@@ -0,0 +1,145 @@
+package releaserserver
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func appStatus(name string, releases ...*releaser_protobuf.ReleaseStatus) *releaser_protobuf.ApplicationStatus {
+	return &releaser_protobuf.ApplicationStatus{Name: name, ReleaseStatus: releases}
+}
+
+func eventTypes(events []*releaser_protobuf.StatusEvent) []string {
+	var ret []string
+	for _, ev := range events {
+		ret = append(ret, ev.ApplicationName+"/"+ev.ReleaseName+" "+ev.Type.String())
+	}
+	return ret
+}
+
+func TestStatusChanges(t *testing.T) {
+	pending := releaser_protobuf.ReleaseStatus_PENDING
+	released := releaser_protobuf.ReleaseStatus_RELEASED
+	openPR := func(number int64, draft bool, approvals int32) *releaser_protobuf.PullRequestStatus {
+		return &releaser_protobuf.PullRequestStatus{State: releaser_protobuf.PullRequestStatus_STATE_OPEN, Draft: draft, Approvals: approvals}
+	}
+	prev := []*releaser_protobuf.ApplicationStatus{
+		appStatus("a1",
+			&releaser_protobuf.ReleaseStatus{Name: "01-dev", Status: released},
+			&releaser_protobuf.ReleaseStatus{Name: "02-prod", Status: released},
+		),
+		appStatus("a2",
+			&releaser_protobuf.ReleaseStatus{Name: "02-prod", Status: pending, PrNumber: 3, PullRequest: openPR(3, false, 0)},
+			&releaser_protobuf.ReleaseStatus{Name: "03-eu", Status: pending, PrNumber: 4, PullRequest: openPR(4, false, 0)},
+			&releaser_protobuf.ReleaseStatus{Name: "04-us", Status: pending, PrNumber: 5, PullRequest: openPR(5, false, 0)},
+		),
+	}
+	cur := []*releaser_protobuf.ApplicationStatus{
+		appStatus("a1",
+			&releaser_protobuf.ReleaseStatus{Name: "01-dev", Status: released},
+			&releaser_protobuf.ReleaseStatus{Name: "02-prod", Status: pending, PrNumber: 7, PullRequest: openPR(7, false, 0)},
+		),
+		appStatus("a2",
+			&releaser_protobuf.ReleaseStatus{Name: "03-eu", Status: released},
+			&releaser_protobuf.ReleaseStatus{Name: "04-us", Status: pending, PrNumber: 5, PullRequest: openPR(5, true, 1)},
+		),
+		appStatus("a3",
+			&releaser_protobuf.ReleaseStatus{Name: "01-dev", Status: released},
+		),
+	}
+	events := statusChanges("deploy", prev, cur, func(number int64) releaser_protobuf.PullRequestStatus_State {
+		require.Equal(t, int64(4), number)
+		return releaser_protobuf.PullRequestStatus_STATE_MERGED
+	})
+	require.Equal(t, []string{
+		"a1/02-prod TYPE_RELEASE_PENDING",
+		"a1/02-prod TYPE_PULL_REQUEST_OPENED",
+		"a2/03-eu TYPE_RELEASE_RELEASED",
+		"a2/03-eu TYPE_PULL_REQUEST_MERGED",
+		"a2/04-us TYPE_HOLD_ADDED",
+		"a2/04-us TYPE_PULL_REQUEST_UPDATED",
+		"a3/01-dev TYPE_RELEASE_ADDED",
+		"a2/02-prod TYPE_RELEASE_REMOVED",
+	}, eventTypes(events))
+	require.Equal(t, int64(4), events[3].PullRequestId)
+	require.Equal(t, "deploy", events[0].Repository)
+	require.Empty(t, statusChanges("deploy", cur, cur, nil))
+}
+
+func TestStatusEventsSince(t *testing.T) {
+	e := newStatusEvents()
+	status := []*releaser_protobuf.ApplicationStatus{appStatus("a1", &releaser_protobuf.ReleaseStatus{Name: "01-dev"})}
+	e.update("deploy", status, nil)
+	events, _ := e.since("")
+	require.Equal(t, []string{"/ TYPE_RESET"}, eventTypes(events))
+	start := events[0].Id
+
+	e.update("deploy", append(status, appStatus("a2", &releaser_protobuf.ReleaseStatus{Name: "01-dev"})), nil)
+	events, _ = e.since(start)
+	require.Equal(t, []string{"a2/01-dev TYPE_RELEASE_ADDED"}, eventTypes(events))
+	after, published := e.since(events[0].Id)
+	require.Empty(t, after)
+	e.publish(statusChanges("deploy", nil, status, nil))
+	<-published
+
+	// Tokens of other processes, and events that were forgotten, need the client to start over
+	events, _ = e.since("other-1")
+	require.Equal(t, releaser_protobuf.StatusEvent_TYPE_RESET, events[0].Type)
+	for i := 0; i < maxStatusEvents; i++ {
+		e.publish(statusChanges("deploy", nil, status, nil))
+	}
+	events, _ = e.since(start)
+	require.Equal(t, releaser_protobuf.StatusEvent_TYPE_RESET, events[0].Type)
+}
+
+func TestServeEvents(t *testing.T) {
+	s := &Server{Logger: zap.NewNop(), events: newStatusEvents()}
+	srv := httptest.NewServer(http.HandlerFunc(s.ServeEvents))
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?repository=deploy", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	lines := bufio.NewScanner(resp.Body)
+	readEvent := func() []string {
+		var ret []string
+		for lines.Scan() && lines.Text() != "" {
+			ret = append(ret, lines.Text())
+		}
+		return ret
+	}
+	reset := readEvent()
+	require.Equal(t, "event: reset", reset[1])
+
+	status := []*releaser_protobuf.ApplicationStatus{appStatus("a1", &releaser_protobuf.ReleaseStatus{Name: "01-dev"})}
+	s.events.publish(statusChanges("other", nil, status, nil))
+	s.events.publish(statusChanges("deploy", nil, status, nil))
+	added := readEvent()
+	require.Equal(t, "id: "+s.events.token(2), added[0])
+	require.Equal(t, "event: release_added", added[1])
+	require.True(t, strings.HasPrefix(added[2], "data: {"))
+	require.Contains(t, added[2], `"repository":"deploy"`)
+
+	// Shutting down ends the stream, and refuses new ones
+	s.CloseEvents()
+	require.Empty(t, readEvent())
+	require.NoError(t, lines.Err())
+	closed, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, closed.Body.Close())
+	require.Equal(t, http.StatusServiceUnavailable, closed.StatusCode)
+}
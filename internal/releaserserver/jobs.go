@@ -0,0 +1,339 @@
+package releaserserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultMaxRunningJobs is how many promotions run at once, across every repository
+	defaultMaxRunningJobs = 4
+	// maxJobs is how many jobs are remembered for GetJob and ListJobs.  The oldest finished jobs are forgotten first.
+	maxJobs = 500
+	// maxJobLogLines bounds the log kept for a single job
+	maxJobLogLines = 1000
+	// jobTimeout bounds a single promotion, including the time it waits in the queue
+	jobTimeout = 30 * time.Minute
+	// defaultListJobsLimit is how many jobs ListJobs returns if the request has no limit
+	defaultListJobsLimit = 50
+)
+
+// jobQueue runs promotions in the background, so they finish even if the client that started them disconnects.  Jobs
+// only live in memory, and are lost when the server restarts.
+type jobQueue struct {
+	// ctx is the lifetime of the server, which bounds every job
+	ctx     context.Context
+	workers chan struct{}
+	// promote runs a promotion.  It is replaced by tests.
+	promote func(r *Repository, ctx context.Context, request *releaser_protobuf.PushPromotionRequest, j *job) (*releaser_protobuf.PushPromotionResponse, error)
+
+	mu   sync.Mutex
+	jobs map[string]*job
+	// order holds the IDs of jobs, oldest first
+	order []string
+	// queued holds the jobs that have not started yet, by jobKey
+	queued map[string]*job
+}
+
+func newJobQueue(ctx context.Context, maxRunning int) *jobQueue {
+	return &jobQueue{
+		ctx:     ctx,
+		workers: make(chan struct{}, maxRunning),
+		promote: (*Repository).runPromotion,
+		jobs:    make(map[string]*job),
+		queued:  make(map[string]*job),
+	}
+}
+
+// jobKey identifies promotions that do the same thing, so they are only queued once
+func jobKey(repository string, request *releaser_protobuf.PushPromotionRequest) string {
+	return strings.Join([]string{repository, request.ApplicationName, request.ReleaseName, strconv.FormatBool(request.OverwriteForeignCommits)}, "/")
+}
+
+// start queues a promotion in r, and returns its job.  If the same promotion is queued but has not started, that job
+// is returned instead, along with true.
+func (q *jobQueue) start(r *Repository, request *releaser_protobuf.PushPromotionRequest) (*job, bool) {
+	key := jobKey(r.ID, request)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if j, exists := q.queued[key]; exists {
+		return j, true
+	}
+	j := newJob(r, request)
+	q.jobs[j.id] = j
+	q.order = append(q.order, j.id)
+	q.queued[key] = j
+	q.forgetFinished()
+	go q.run(r, key, j, request)
+	return j, false
+}
+
+// run waits for other promotions of the application, and for a free worker, then promotes
+func (q *jobQueue) run(r *Repository, key string, j *job, request *releaser_protobuf.PushPromotionRequest) {
+	ctx, cancel := context.WithTimeout(q.ctx, jobTimeout)
+	defer cancel()
+	unlock := r.promotionLocks.Lock(request.ApplicationName)
+	defer unlock()
+	select {
+	case q.workers <- struct{}{}:
+	case <-ctx.Done():
+		q.dequeue(key, j)
+		j.finish(nil, fmt.Errorf("job did not start: %w", ctx.Err()))
+		return
+	}
+	defer func() {
+		<-q.workers
+	}()
+	q.dequeue(key, j)
+	j.setRunning()
+	resp, err := q.promote(r, ctx, request, j)
+	j.finish(resp, err)
+}
+
+// dequeue stops j from being returned for new requests, once it starts
+func (q *jobQueue) dequeue(key string, j *job) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.queued[key] == j {
+		delete(q.queued, key)
+	}
+}
+
+// forgetFinished drops the oldest finished jobs while there are too many.  q.mu must be held.
+func (q *jobQueue) forgetFinished() {
+	for idx := 0; len(q.order) > maxJobs && idx < len(q.order); {
+		id := q.order[idx]
+		if !q.jobs[id].finished() {
+			idx++
+			continue
+		}
+		delete(q.jobs, id)
+		q.order = append(q.order[:idx], q.order[idx+1:]...)
+	}
+}
+
+func (q *jobQueue) get(id string) (*job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	j, exists := q.jobs[id]
+	return j, exists
+}
+
+// list returns up to limit jobs matching filter, newest first
+func (q *jobQueue) list(filter func(j *job) bool, limit int) []*job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var ret []*job
+	for idx := len(q.order) - 1; idx >= 0 && len(ret) < limit; idx-- {
+		if j := q.jobs[q.order[idx]]; filter(j) {
+			ret = append(ret, j)
+		}
+	}
+	return ret
+}
+
+// job is a single promotion run by a jobQueue
+type job struct {
+	id          string
+	repository  string
+	application string
+	release     string
+	// logger writes to the server log and to the log of the job
+	logger *zap.Logger
+	done   chan struct{}
+
+	mu       sync.Mutex
+	status   *releaser_protobuf.Job
+	response *releaser_protobuf.PushPromotionResponse
+	err      error
+}
+
+func newJob(r *Repository, request *releaser_protobuf.PushPromotionRequest) *job {
+	j := &job{
+		id:          uuid.NewString(),
+		repository:  r.ID,
+		application: request.ApplicationName,
+		release:     request.ReleaseName,
+		done:        make(chan struct{}),
+	}
+	j.status = &releaser_protobuf.Job{
+		Id:              j.id,
+		Repository:      r.ID,
+		ApplicationName: request.ApplicationName,
+		ReleaseName:     request.ReleaseName,
+		Actor:           request.Actor,
+		State:           releaser_protobuf.Job_STATE_QUEUED,
+		CreatedAt:       formatJobTime(time.Now()),
+	}
+	encoder := zap.NewProductionEncoderConfig()
+	encoder.EncodeTime = zapcore.RFC3339TimeEncoder
+	jobLog := zapcore.NewCore(zapcore.NewConsoleEncoder(encoder), zapcore.AddSync(j), zap.InfoLevel)
+	j.logger = zap.New(zapcore.NewTee(r.Logger.With(zap.String("job", j.id)).Core(), releaser.RedactingCore(jobLog)))
+	return j
+}
+
+func formatJobTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Write adds lines to the log of the job
+func (j *job) Write(p []byte) (int, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		switch {
+		case len(j.status.Logs) < maxJobLogLines:
+			j.status.Logs = append(j.status.Logs, line)
+		case len(j.status.Logs) == maxJobLogLines:
+			j.status.Logs = append(j.status.Logs, "... log truncated")
+		}
+	}
+	return len(p), nil
+}
+
+func (j *job) setRunning() {
+	j.mu.Lock()
+	j.status.State = releaser_protobuf.Job_STATE_RUNNING
+	j.status.StartedAt = formatJobTime(time.Now())
+	j.mu.Unlock()
+	j.logger.Info("started promotion", zap.String("application", j.application), zap.String("release", j.release))
+}
+
+// step marks the step that is running done, and starts the one called name
+func (j *job) step(name string) {
+	j.mu.Lock()
+	now := formatJobTime(time.Now())
+	j.endStep(releaser_protobuf.JobStep_STATE_DONE, now)
+	j.status.Steps = append(j.status.Steps, &releaser_protobuf.JobStep{
+		Name:      name,
+		State:     releaser_protobuf.JobStep_STATE_RUNNING,
+		StartedAt: now,
+	})
+	j.mu.Unlock()
+	j.logger.Info("starting " + name)
+}
+
+// endStep ends the step that is running, if there is one.  j.mu must be held.
+func (j *job) endStep(state releaser_protobuf.JobStep_State, now string) {
+	if len(j.status.Steps) == 0 {
+		return
+	}
+	last := j.status.Steps[len(j.status.Steps)-1]
+	if last.State == releaser_protobuf.JobStep_STATE_RUNNING {
+		last.State = state
+		last.FinishedAt = now
+	}
+}
+
+func (j *job) finish(response *releaser_protobuf.PushPromotionResponse, err error) {
+	if err != nil {
+		j.logger.Warn("promotion failed", zap.Error(err))
+	} else {
+		j.logger.Info("promotion finished", zap.String("status", response.Status.String()), zap.Int64("pull_request", response.PullRequestId))
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := formatJobTime(time.Now())
+	j.response, j.err = response, err
+	j.status.FinishedAt = now
+	if err != nil {
+		j.endStep(releaser_protobuf.JobStep_STATE_FAILED, now)
+		j.status.State = releaser_protobuf.Job_STATE_FAILED
+		j.status.Error = releaser.Redact(err.Error())
+	} else {
+		j.endStep(releaser_protobuf.JobStep_STATE_DONE, now)
+		j.status.State = releaser_protobuf.Job_STATE_SUCCEEDED
+		j.status.Result = response
+	}
+	close(j.done)
+}
+
+func (j *job) finished() bool {
+	select {
+	case <-j.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait returns the result of the job once it finishes.  The job keeps running if ctx ends first.
+func (j *job) wait(ctx context.Context) (*releaser_protobuf.PushPromotionResponse, error) {
+	select {
+	case <-j.done:
+		return j.response, j.err
+	case <-ctx.Done():
+		return nil, twirp.NewError(twirp.DeadlineExceeded, fmt.Sprintf("promotion is still running as job %s", j.id)).WithMeta("job_id", j.id)
+	}
+}
+
+// snapshot returns the progress of the job so far
+func (j *job) snapshot() *releaser_protobuf.Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return proto.Clone(j.status).(*releaser_protobuf.Job)
+}
+
+func (s *Server) StartPromotion(_ context.Context, request *releaser_protobuf.StartPromotionRequest) (*releaser_protobuf.StartPromotionResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireReleaseArguments(request.ApplicationName, request.ReleaseName); err != nil {
+		return nil, err
+	}
+	j, deduplicated := s.jobs.start(r, &releaser_protobuf.PushPromotionRequest{
+		ApplicationName:         request.ApplicationName,
+		ReleaseName:             request.ReleaseName,
+		Repository:              r.ID,
+		Actor:                   request.Actor,
+		OverwriteForeignCommits: request.OverwriteForeignCommits,
+	})
+	return &releaser_protobuf.StartPromotionResponse{
+		Job:          j.snapshot(),
+		Deduplicated: deduplicated,
+	}, nil
+}
+
+func (s *Server) GetJob(_ context.Context, request *releaser_protobuf.GetJobRequest) (*releaser_protobuf.GetJobResponse, error) {
+	if request.JobId == "" {
+		return nil, twirp.RequiredArgumentError("job_id")
+	}
+	j, exists := s.jobs.get(request.JobId)
+	if !exists {
+		return nil, twirp.NotFoundError(fmt.Sprintf("unknown job %s", request.JobId))
+	}
+	return &releaser_protobuf.GetJobResponse{Job: j.snapshot()}, nil
+}
+
+func (s *Server) ListJobs(_ context.Context, request *releaser_protobuf.ListJobsRequest) (*releaser_protobuf.ListJobsResponse, error) {
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultListJobsLimit
+	}
+	matches := func(want string, got string) bool {
+		return want == "" || want == got
+	}
+	jobs := s.jobs.list(func(j *job) bool {
+		return matches(request.Repository, j.repository) && matches(request.ApplicationName, j.application) && matches(request.ReleaseName, j.release)
+	}, limit)
+	ret := &releaser_protobuf.ListJobsResponse{
+		Jobs: make([]*releaser_protobuf.Job, 0, len(jobs)),
+	}
+	for _, j := range jobs {
+		ret.Jobs = append(ret.Jobs, j.snapshot())
+	}
+	return ret, nil
+}
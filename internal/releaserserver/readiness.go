@@ -0,0 +1,34 @@
+package releaserserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"go.uber.org/zap"
+)
+
+// repositoryReadiness is whether a repository can talk to its code host
+type repositoryReadiness struct {
+	ID    string               `json:"id"`
+	Token releaser.TokenHealth `json:"token"`
+}
+
+// ServeReadiness reports the access token health of every repository.  It answers 503 if any repository has no
+// working token, so the server is taken out of rotation instead of failing requests.
+func (s *Server) ServeReadiness(w http.ResponseWriter, _ *http.Request) {
+	ret := make([]repositoryReadiness, 0, len(s.repositoryOrder))
+	status := http.StatusOK
+	for _, rid := range s.repositoryOrder {
+		health := s.repositories[rid].Repo.Host.AccessTokens().Health()
+		if !health.Healthy {
+			status = http.StatusServiceUnavailable
+		}
+		ret = append(ret, repositoryReadiness{ID: rid, Token: health})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(ret); err != nil {
+		s.Logger.Warn("failed to write readiness", zap.Error(err))
+	}
+}
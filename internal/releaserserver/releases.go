@@ -0,0 +1,199 @@
+package releaserserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"github.com/twitchtv/twirp"
+)
+
+// readSnapshot runs f against the latest snapshot of the default branch, and returns the SHA it was at.  The snapshot
+// is separate from the checkout promotions use, so reads can run alongside them.
+func (r *Repository) readSnapshot(ctx context.Context, f func(api *releaser.FromCommandLine) error) (string, error) {
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return "", fmt.Errorf("failed to fetch from origin: %w", err)
+	}
+	var ret string
+	err := r.Repo.WithSnapshot(ctx, func(snapshot *managedgitrepo.Worktree, sha string) error {
+		ret = sha
+		return f(r.apiFor(snapshot))
+	})
+	return ret, err
+}
+
+// requireRelease fails with NotFound unless the application, and the release if one is given, exist
+func requireRelease(api releaser.Api, application string, release string) error {
+	apps, err := api.ListApplications()
+	if err != nil {
+		return fmt.Errorf("failed to list applications: %w", err)
+	}
+	if !contains(apps, application) {
+		return twirp.NotFoundError(fmt.Sprintf("unknown application %s", application))
+	}
+	if release == "" {
+		return nil
+	}
+	releases, err := api.ListReleases(application)
+	if err != nil {
+		return fmt.Errorf("failed to list releases of %s: %w", application, err)
+	}
+	if !contains(releases, release) {
+		return twirp.NotFoundError(fmt.Sprintf("unknown release %s:%s", application, release))
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func releaseFilesAsProto(release *releaser.Release) []*releaser_protobuf.ReleaseFile {
+	release.SortFilesByNameAndDirectory()
+	ret := make([]*releaser_protobuf.ReleaseFile, 0, len(release.Files))
+	for _, f := range release.Files {
+		ret = append(ret, &releaser_protobuf.ReleaseFile{
+			Directory: f.Directory,
+			Name:      f.Name,
+			Content:   f.Content,
+		})
+	}
+	return ret
+}
+
+func (s *Server) ListApplications(ctx context.Context, request *releaser_protobuf.ListApplicationsRequest) (*releaser_protobuf.ListApplicationsResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	var ret releaser_protobuf.ListApplicationsResponse
+	ret.GitSha, err = r.readSnapshot(ctx, func(api *releaser.FromCommandLine) error {
+		var err error
+		ret.ApplicationNames, err = api.ListApplications()
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	return &ret, nil
+}
+
+func (s *Server) ListReleases(ctx context.Context, request *releaser_protobuf.ListReleasesRequest) (*releaser_protobuf.ListReleasesResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if request.ApplicationName == "" {
+		return nil, twirp.RequiredArgumentError("application_name")
+	}
+	var ret releaser_protobuf.ListReleasesResponse
+	ret.GitSha, err = r.readSnapshot(ctx, func(api *releaser.FromCommandLine) error {
+		if err := requireRelease(api, request.ApplicationName, ""); err != nil {
+			return err
+		}
+		var err error
+		ret.ReleaseNames, err = api.ListReleases(request.ApplicationName)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases of %s: %w", request.ApplicationName, err)
+	}
+	return &ret, nil
+}
+
+func (s *Server) GetRelease(ctx context.Context, request *releaser_protobuf.GetReleaseRequest) (*releaser_protobuf.GetReleaseResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireReleaseArguments(request.ApplicationName, request.ReleaseName); err != nil {
+		return nil, err
+	}
+	var ret releaser_protobuf.GetReleaseResponse
+	ret.GitSha, err = r.readSnapshot(ctx, func(api *releaser.FromCommandLine) error {
+		if err := requireRelease(api, request.ApplicationName, request.ReleaseName); err != nil {
+			return err
+		}
+		release, err := api.GetRelease(request.ApplicationName, request.ReleaseName)
+		if err != nil {
+			return err
+		}
+		ret.Files = releaseFilesAsProto(release)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %s:%s: %w", request.ApplicationName, request.ReleaseName, err)
+	}
+	return &ret, nil
+}
+
+func (s *Server) PreviewRelease(ctx context.Context, request *releaser_protobuf.PreviewReleaseRequest) (*releaser_protobuf.PreviewReleaseResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireReleaseArguments(request.ApplicationName, request.ReleaseName); err != nil {
+		return nil, err
+	}
+	var ret releaser_protobuf.PreviewReleaseResponse
+	ret.GitSha, err = r.readSnapshot(ctx, func(api *releaser.FromCommandLine) error {
+		if err := requireRelease(api, request.ApplicationName, request.ReleaseName); err != nil {
+			return err
+		}
+		oldRelease, newRelease, err := api.PreviewRelease(ctx, request.ApplicationName, request.ReleaseName, true)
+		if err != nil {
+			return err
+		}
+		ret.Diff, err = releaser.UnifiedDiff(oldRelease, newRelease)
+		if err != nil {
+			return fmt.Errorf("failed to diff release: %w", err)
+		}
+		ret.OldFiles = releaseFilesAsProto(oldRelease)
+		ret.NewFiles = releaseFilesAsProto(newRelease)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview release %s:%s: %w", request.ApplicationName, request.ReleaseName, err)
+	}
+	return &ret, nil
+}
+
+func (s *Server) NeedsPromotion(ctx context.Context, request *releaser_protobuf.NeedsPromotionRequest) (*releaser_protobuf.NeedsPromotionResponse, error) {
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireReleaseArguments(request.ApplicationName, request.ReleaseName); err != nil {
+		return nil, err
+	}
+	var ret releaser_protobuf.NeedsPromotionResponse
+	ret.GitSha, err = r.readSnapshot(ctx, func(api *releaser.FromCommandLine) error {
+		if err := requireRelease(api, request.ApplicationName, request.ReleaseName); err != nil {
+			return err
+		}
+		var err error
+		ret.NeedsPromotion, err = releaser.NeedsPromotion(ctx, api, request.ApplicationName, request.ReleaseName)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if %s:%s needs promotion: %w", request.ApplicationName, request.ReleaseName, err)
+	}
+	return &ret, nil
+}
+
+func requireReleaseArguments(application string, release string) error {
+	if application == "" {
+		return twirp.RequiredArgumentError("application_name")
+	}
+	if release == "" {
+		return twirp.RequiredArgumentError("release_name")
+	}
+	return nil
+}
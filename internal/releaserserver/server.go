@@ -2,7 +2,9 @@ package releaserserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,14 +12,121 @@ import (
 	"github.com/cresta/cresta-releaser/releaser"
 	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
 	"github.com/cresta/zapctx"
+	"github.com/twitchtv/twirp"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type Server struct {
+	Logger       *zap.Logger
+	repositories map[string]*Repository
+	// repositoryOrder is the order repositories were configured in, so aggregated responses are stable
+	repositoryOrder []string
+	jobs            *jobQueue
+	events          *statusEvents
+}
+
+// Repository is a single managed git repository, with its own checkout and code host client.  Promotions run in their
+// own worktrees, so promotions of different applications run in parallel.
+type Repository struct {
+	ID     string
 	Logger *zap.Logger
-	Api    releaser.Api
-	Repo   *managedgitrepo.Repo
-	mu     sync.RWMutex
+	// Api operates on the main checkout of the repository
+	Api            releaser.Api
+	Repo           *managedgitrepo.Repo
+	promotionLocks keyedMutex
+	// jobs runs the promotions of the repository
+	jobs *jobQueue
+	// reconcileMu stops pull requests from being reconciled twice at once
+	reconcileMu sync.Mutex
+	// statusMu guards status, the release status as of the last SHA it was computed for
+	statusMu sync.Mutex
+	status   *statusSnapshot
+	config   RepositoryConfig
+	// remote is where the repository lives on its code host, which is how webhooks refer to it
+	remote *releaser.RemoteURL
+}
+
+// NewRepository clones (or resets) the repository described by cfg and sets up the API used to release from it
+func NewRepository(ctx context.Context, logger *zap.Logger, cfg RepositoryConfig, authorName string, authorEmail string) (*Repository, error) {
+	logger = logger.With(zap.String("repository", cfg.ID))
+	remote, err := releaser.ParseRemoteURL(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url for %s: %w", cfg.ID, err)
+	}
+	host, err := releaser.NewCodeHost(ctx, logger, &releaser.CodeHostConfig{
+		Provider: cfg.Provider,
+		Remote:   remote,
+		Github: &releaser.NewGQLClientConfig{
+			AppID:          cfg.Github.AppID,
+			InstallationID: cfg.Github.InstallationID,
+			PEMKeyLoc:      cfg.Github.PEMKeyLoc,
+			Token:          cfg.Github.token(),
+			BaseURL:        cfg.Github.BaseURL,
+		},
+		Gitlab: &releaser.GitlabConfig{
+			BaseURL: cfg.Gitlab.BaseURL,
+			Token:   cfg.Gitlab.token(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create code host client for %s: %w", cfg.ID, err)
+	}
+	// Refresh expiring tokens ahead of time, for as long as the server runs
+	tokens := host.AccessTokens()
+	go tokens.KeepFresh(ctx)
+	newGit := func(dir string) releaser.Git {
+		return &releaser.GitCli{
+			Logger:                logger,
+			Dir:                   dir,
+			DefaultBranchName:     cfg.DefaultBranch,
+			Signing:               cfg.Signing.commitSigning(),
+			PartialCloneFilter:    cfg.Fetch.Filter,
+			SparseCheckout:        cfg.Fetch.sparseCheckout(),
+			Credentials:           tokens.Token,
+			InvalidateCredentials: tokens.Invalidate,
+		}
+	}
+	newFs := func(dir string) releaser.FileSystem {
+		return &releaser.OSFileSystem{
+			Logger: logger,
+			Root:   dir,
+		}
+	}
+	repo, err := managedgitrepo.NewRepo(ctx, cfg.DiskLocation, cfg.URL, host, newGit, newFs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup repository %s: %w", cfg.ID, err)
+	}
+	if err := repo.VerifyOrSetAuthorInfo(ctx, authorName, authorEmail); err != nil {
+		return nil, fmt.Errorf("failed to set author info for %s: %w", cfg.ID, err)
+	}
+	return &Repository{
+		ID:     cfg.ID,
+		Logger: logger,
+		Api: &releaser.FromCommandLine{
+			Logger:                logger,
+			Fs:                    repo.Fs,
+			Git:                   repo.G,
+			CodeHost:              host,
+			CommitThroughGithub:   cfg.Signing.ThroughGithub,
+			CommitMessageTemplate: cfg.CommitMessageTemplate,
+		},
+		Repo:   repo,
+		config: cfg,
+		remote: remote,
+	}, nil
+}
+
+// apiFor returns an API that operates inside a worktree of the repository
+func (r *Repository) apiFor(wt *managedgitrepo.Worktree) *releaser.FromCommandLine {
+	return &releaser.FromCommandLine{
+		Logger:                r.Logger,
+		Fs:                    wt.Fs,
+		Git:                   wt.G,
+		CodeHost:              r.Repo.Host,
+		CommitThroughGithub:   r.config.Signing.ThroughGithub,
+		CommitMessageTemplate: r.config.CommitMessageTemplate,
+	}
 }
 
 func CronRefresh(ctx context.Context, s *Server, cronInterval time.Duration) {
@@ -31,124 +140,343 @@ func CronRefresh(ctx context.Context, s *Server, cronInterval time.Duration) {
 			case <-ctx.Done():
 				return
 			case <-time.After(cronInterval):
-				if _, err := s.RefreshRepository(ctx, nil); err != nil {
+				if _, err := s.RefreshRepository(ctx, &releaser_protobuf.RefreshRepositoryRequest{}); err != nil {
 					s.Logger.Error("failed to refresh repository", zap.Error(err))
 				}
+				for _, rid := range s.repositoryOrder {
+					r := s.repositories[rid]
+					if !r.config.ReconcilePullRequests {
+						continue
+					}
+					if _, err := r.reconcilePullRequests(ctx, false); err != nil {
+						r.Logger.Error("failed to reconcile pull requests", zap.Error(err))
+					}
+				}
 			}
 		}
 	}()
 }
 
-func (s *Server) RefreshRepository(ctx context.Context, _ *releaser_protobuf.RefreshRepositoryRequest) (*releaser_protobuf.RefreshRepositoryResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if err := s.Repo.G.ForceRemoteRefresh(ctx); err != nil {
-		return nil, fmt.Errorf("failed to refresh repository: %w", err)
+// repository returns the repository a request refers to.  An empty id is only allowed when there is exactly one
+// repository.
+func (s *Server) repository(id string) (*Repository, error) {
+	if id == "" {
+		if len(s.repositoryOrder) == 1 {
+			return s.repositories[s.repositoryOrder[0]], nil
+		}
+		return nil, twirp.RequiredArgumentError("repository")
+	}
+	r, exists := s.repositories[id]
+	if !exists {
+		return nil, twirp.NotFoundError(fmt.Sprintf("unknown repository %s", id))
+	}
+	return r, nil
+}
+
+// repositoriesFor returns every repository if id is empty, otherwise just the one repository id refers to
+func (s *Server) repositoriesFor(id string) ([]*Repository, error) {
+	if id != "" {
+		r, err := s.repository(id)
+		if err != nil {
+			return nil, err
+		}
+		return []*Repository{r}, nil
+	}
+	ret := make([]*Repository, 0, len(s.repositoryOrder))
+	for _, rid := range s.repositoryOrder {
+		ret = append(ret, s.repositories[rid])
 	}
-	return nil, nil
+	return ret, nil
+}
+
+func (s *Server) RefreshRepository(ctx context.Context, request *releaser_protobuf.RefreshRepositoryRequest) (*releaser_protobuf.RefreshRepositoryResponse, error) {
+	repos, err := s.repositoriesFor(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range repos {
+		if err := r.refresh(ctx); err != nil {
+			return nil, fmt.Errorf("failed to refresh repository %s: %w", r.ID, err)
+		}
+	}
+	return &releaser_protobuf.RefreshRepositoryResponse{}, nil
+}
+
+func (r *Repository) refresh(ctx context.Context) error {
+	return r.Repo.Fetch(ctx, true)
 }
 
 func (s *Server) PushPromotion(ctx context.Context, request *releaser_protobuf.PushPromotionRequest) (*releaser_protobuf.PushPromotionResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if err := s.Repo.ResetExistingToOrigin(ctx); err != nil {
-		return nil, fmt.Errorf("failed to reset to origin: %w", err)
+	r, err := s.repository(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	return r.pushPromotion(ctx, request)
+}
+
+// pushPromotion runs a promotion as a job and waits for it to finish.  If ctx ends first, the promotion keeps running
+// and the error names its job.
+func (r *Repository) pushPromotion(ctx context.Context, request *releaser_protobuf.PushPromotionRequest) (*releaser_protobuf.PushPromotionResponse, error) {
+	j, _ := r.jobs.start(r, request)
+	return j.wait(ctx)
+}
+
+// runPromotion promotes from a fresh worktree, and reports its steps to j.  The caller must hold the promotion lock of
+// the application.
+func (r *Repository) runPromotion(ctx context.Context, request *releaser_protobuf.PushPromotionRequest, j *job) (*releaser_protobuf.PushPromotionResponse, error) {
+	j.step("fetch")
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return nil, fmt.Errorf("failed to fetch from origin: %w", err)
 	}
+	defaultBranch, err := r.Repo.G.DefaultBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default branch: %w", err)
+	}
+	j.step("checkout")
+	wt, err := r.Repo.NewWorktree(ctx, "promote-"+request.ApplicationName, "origin/"+defaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree: %w", err)
+	}
+	defer func() {
+		// Use a fresh context: the worktree should be removed even if the request was cancelled
+		if err := wt.Close(context.Background()); err != nil {
+			r.Logger.Warn("failed to remove worktree", zap.String("dir", wt.Dir), zap.Error(err))
+		}
+	}()
+	api := r.apiFor(wt)
+	api.Logger = j.logger
+	api.Actor = request.Actor
+	api.OverwriteForeignCommits = request.OverwriteForeignCommits
 	branchName := releaser.DefaultBranchNameForRelease(request.ApplicationName, request.ReleaseName)
-	if pr, err := s.Api.CheckForPRForBranch(ctx, branchName); err != nil {
-		return nil, fmt.Errorf("failed to check for existing PR for branch %s: %w", branchName, err)
-	} else if pr != 0 {
-		return &releaser_protobuf.PushPromotionResponse{
-			Status:        releaser_protobuf.PushPromotionResponse_EXISTING_PULL_REQUEST,
-			PullRequestId: pr,
-		}, nil
-	}
-	if err := s.Repo.G.ResetToOriginalBranch(ctx); err != nil {
-		return nil, fmt.Errorf("failed to reset to original branch: %w", err)
-	}
-	if exists, err := s.Repo.G.DoesBranchExist(ctx, branchName); err != nil {
+	if exists, err := wt.G.DoesBranchExist(ctx, branchName); err != nil {
 		return nil, fmt.Errorf("failed to check if branch %s exists: %w", branchName, err)
 	} else if exists {
-		if err := s.Repo.G.ForceDeleteLocalBranch(ctx, branchName); err != nil {
+		if err := wt.G.ForceDeleteLocalBranch(ctx, branchName); err != nil {
 			return nil, fmt.Errorf("failed to delete branch %s: %w", branchName, err)
 		}
 	}
-	if err := s.Api.FreshGitBranch(ctx, request.ApplicationName, request.ReleaseName, ""); err != nil {
-		return nil, fmt.Errorf("failed to create branch %s: %w", branchName, err)
+	ctx = releaser.WithPromoteProgress(ctx, func(step releaser.PromoteStep) {
+		j.step(string(step))
+	})
+	result, err := releaser.Promote(ctx, api, request.ApplicationName, request.ReleaseName)
+	if err != nil {
+		return nil, pushError(fmt.Errorf("failed to promote %s:%s: %w", request.ApplicationName, request.ReleaseName, err))
+	}
+	return &releaser_protobuf.PushPromotionResponse{
+		Status:        promoteStatusAsProto(result.Status),
+		PullRequestId: result.PullRequest,
+		CommitSha:     result.CommitSha,
+	}, nil
+}
+
+func promoteStatusAsProto(status releaser.PromoteStatus) releaser_protobuf.PushPromotionResponse_Status {
+	switch status {
+	case releaser.PROMOTE_STATUS_NO_CHANGES:
+		return releaser_protobuf.PushPromotionResponse_NO_CHANGES
+	case releaser.PROMOTE_STATUS_EXISTING_PULL_REQUEST:
+		return releaser_protobuf.PushPromotionResponse_EXISTING_PULL_REQUEST
+	case releaser.PROMOTE_STATUS_NEW_PULL_REQUEST:
+		return releaser_protobuf.PushPromotionResponse_NEW_PULL_REQUEST
+	case releaser.PROMOTE_STATUS_DIRECT_COMMIT:
+		return releaser_protobuf.PushPromotionResponse_DIRECT_COMMIT
+	case releaser.PROMOTE_STATUS_UPDATED_PULL_REQUEST:
+		return releaser_protobuf.PushPromotionResponse_UPDATED_PULL_REQUEST
+	default:
+		return releaser_protobuf.PushPromotionResponse_UNKNOWN
 	}
-	oldRelease, newRelease, err := s.Api.PreviewRelease(ctx, request.ApplicationName, request.ReleaseName, false)
+}
+
+func (s *Server) EnableAutoMerge(ctx context.Context, request *releaser_protobuf.EnableAutoMergeRequest) (*releaser_protobuf.EnableAutoMergeResponse, error) {
+	r, err := s.repository(request.GetRepository())
 	if err != nil {
-		return nil, fmt.Errorf("failed to preview release: %w", err)
+		return nil, err
+	}
+	if request.PullRequestId <= 0 {
+		return nil, twirp.RequiredArgumentError("pull_request_id")
+	}
+	method := releaser.MergeMethodSquash
+	switch request.MergeMethod {
+	case releaser_protobuf.EnableAutoMergeRequest_MERGE:
+		method = releaser.MergeMethodMerge
+	case releaser_protobuf.EnableAutoMergeRequest_REBASE:
+		method = releaser.MergeMethodRebase
 	}
-	if err := s.Api.ApplyRelease(request.ApplicationName, request.ReleaseName, oldRelease, newRelease); err != nil {
-		return nil, fmt.Errorf("failed to apply release: %w", err)
+	if err := r.Api.EnableAutoMergeForCurrentRemote(ctx, request.PullRequestId, method); err != nil {
+		return nil, fmt.Errorf("failed to enable auto-merge on PR %d: %w", request.PullRequestId, err)
 	}
-	if changes, err := s.Api.AreThereUncommittedChanges(ctx); err != nil {
-		return nil, fmt.Errorf("failed to check for uncommitted changes: %w", err)
-	} else if !changes {
-		return &releaser_protobuf.PushPromotionResponse{
-			Status: releaser_protobuf.PushPromotionResponse_NO_CHANGES,
-		}, nil
+	return &releaser_protobuf.EnableAutoMergeResponse{}, nil
+}
+
+func (s *Server) ReconcilePullRequests(ctx context.Context, request *releaser_protobuf.ReconcilePullRequestsRequest) (*releaser_protobuf.ReconcilePullRequestsResponse, error) {
+	repos, err := s.repositoriesFor(request.GetRepository())
+	if err != nil {
+		return nil, err
 	}
-	if err := s.Api.CommitForRelease(ctx, request.ApplicationName, request.ReleaseName); err != nil {
-		return nil, fmt.Errorf("failed to commit release: %w", err)
+	var ret releaser_protobuf.ReconcilePullRequestsResponse
+	for _, r := range repos {
+		closed, err := r.reconcilePullRequests(ctx, request.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile pull requests of repository %s: %w", r.ID, err)
+		}
+		for _, c := range closed {
+			ret.ClosedPullRequests = append(ret.ClosedPullRequests, &releaser_protobuf.ClosedPullRequest{
+				Repository:    r.ID,
+				PullRequestId: c.Number,
+				Branch:        c.Branch,
+				Reason:        c.Reason,
+			})
+		}
 	}
-	if err := s.Api.ForcePushCurrentBranch(ctx); err != nil {
-		return nil, fmt.Errorf("failed to push release: %w", err)
+	return &ret, nil
+}
+
+func (r *Repository) reconcilePullRequests(ctx context.Context, dryRun bool) ([]releaser.ReconciledPullRequest, error) {
+	r.reconcileMu.Lock()
+	defer r.reconcileMu.Unlock()
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return nil, fmt.Errorf("failed to fetch from origin: %w", err)
 	}
-	if prNum, err := s.Api.PullRequestCurrent(ctx); err != nil {
-		return nil, fmt.Errorf("failed to create pull request: %w", err)
-	} else {
-		return &releaser_protobuf.PushPromotionResponse{
-			Status:        releaser_protobuf.PushPromotionResponse_NEW_PULL_REQUEST,
-			PullRequestId: prNum,
-		}, nil
+	defaultBranch, err := r.Repo.G.DefaultBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default branch: %w", err)
+	}
+	wt, err := r.Repo.NewWorktree(ctx, "reconcile-pull-requests", "origin/"+defaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree: %w", err)
+	}
+	defer func() {
+		if err := wt.Close(context.Background()); err != nil {
+			r.Logger.Warn("failed to remove worktree", zap.String("dir", wt.Dir), zap.Error(err))
+		}
+	}()
+	return r.apiFor(wt).ReconcilePullRequests(releaser.WithApplicationLock(ctx, r.promotionLocks.Lock), dryRun)
+}
+
+// pushError turns push failures a client can act on into twirp errors
+func pushError(err error) error {
+	var foreign *releaser.ForeignCommitsError
+	if errors.As(err, &foreign) {
+		shas := make([]string, 0, len(foreign.Commits))
+		for _, c := range foreign.Commits {
+			shas = append(shas, c.Sha)
+		}
+		return twirp.NewError(twirp.FailedPrecondition, err.Error()).WithMeta("foreign_commits", strings.Join(shas, ","))
+	}
+	if errors.Is(err, releaser.ErrRemoteBranchChanged) || errors.Is(err, releaser.ErrNonFastForward) {
+		return twirp.NewError(twirp.Aborted, err.Error())
+	}
+	return err
+}
+
+// RedactErrors is a twirp interceptor that removes credentials from the errors returned to clients
+func RedactErrors(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		resp, err := next(ctx, request)
+		if err == nil {
+			return resp, nil
+		}
+		// twirp sends the message of the first twirp.Error err wraps, or err itself as an internal error
+		var terr twirp.Error
+		if !errors.As(err, &terr) {
+			return resp, releaser.RedactError(err)
+		}
+		ret := twirp.NewError(terr.Code(), releaser.Redact(terr.Msg()))
+		for k, v := range terr.MetaMap() {
+			ret = ret.WithMeta(k, releaser.Redact(v))
+		}
+		return resp, ret
 	}
 }
 
-func NewServer(ctx context.Context, logger *zapctx.Logger, api releaser.Api, repo *managedgitrepo.Repo) (*Server, error) {
+func NewServer(ctx context.Context, logger *zapctx.Logger, repositories []*Repository) (*Server, error) {
 	zapLogger := logger.Unwrap(ctx)
-	return &Server{
-		Logger: zapLogger,
-		Api:    api,
-		Repo:   repo,
-	}, nil
+	ret := &Server{
+		Logger:       zapLogger,
+		repositories: make(map[string]*Repository, len(repositories)),
+		jobs:         newJobQueue(ctx, defaultMaxRunningJobs),
+		events:       newStatusEvents(),
+	}
+	for _, r := range repositories {
+		r.jobs = ret.jobs
+		if _, exists := ret.repositories[r.ID]; exists {
+			return nil, fmt.Errorf("duplicate repository %s", r.ID)
+		}
+		ret.repositories[r.ID] = r
+		ret.repositoryOrder = append(ret.repositoryOrder, r.ID)
+	}
+	if len(ret.repositoryOrder) == 0 {
+		return nil, fmt.Errorf("no repositories to serve")
+	}
+	return ret, nil
 }
 
-func (s *Server) GetAllApplicationStatus(ctx context.Context, _ *releaser_protobuf.GetAllApplicationStatusRequest) (*releaser_protobuf.GetAllApplicationStatusResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if err := s.Repo.ResetExistingToOrigin(ctx); err != nil {
-		return nil, fmt.Errorf("failed to reset to origin: %w", err)
+func (s *Server) GetAllApplicationStatus(ctx context.Context, request *releaser_protobuf.GetAllApplicationStatusRequest) (*releaser_protobuf.GetAllApplicationStatusResponse, error) {
+	repos, err := s.repositoriesFor(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([][]*releaser_protobuf.ApplicationStatus, len(repos))
+	snapshots := make([]*releaser_protobuf.StatusSnapshot, len(repos))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for idx, r := range repos {
+		idx, r := idx, r
+		eg.Go(func() error {
+			appStatus, snapshot, err := r.applicationStatus(egCtx)
+			if err != nil {
+				return fmt.Errorf("failed to get application status for repository %s: %w", r.ID, err)
+			}
+			statuses[idx] = appStatus
+			snapshots[idx] = snapshot
+			return nil
+		})
 	}
-	if err := s.Repo.UpdateCheckout(ctx); err != nil {
-		return nil, fmt.Errorf("failed to update checkout: %w", err)
+	if err := eg.Wait(); err != nil {
+		return nil, err
 	}
-	if err := s.Repo.G.ResetClean(ctx); err != nil {
-		return nil, fmt.Errorf("failed to reset git repo: %w", err)
+	ret := releaser_protobuf.GetAllApplicationStatusResponse{
+		Snapshots: snapshots,
 	}
-	if err := s.Repo.G.ResetToOriginalBranch(ctx); err != nil {
-		return nil, fmt.Errorf("failed to reset to original branch: %w", err)
+	for _, s := range statuses {
+		ret.ApplicationStatus = append(ret.ApplicationStatus, s...)
 	}
-	releaseList, err := releaser.GetAllReleaseStatus(ctx, s.Api)
+	return &ret, nil
+}
+
+// applicationStatus returns the cached release status of the default branch of origin, with the current state of
+// each release's PR
+func (r *Repository) applicationStatus(ctx context.Context) ([]*releaser_protobuf.ApplicationStatus, *releaser_protobuf.StatusSnapshot, error) {
+	if err := r.Repo.Fetch(ctx, false); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch from origin: %w", err)
+	}
+	sha, err := r.Repo.OriginDefaultSha(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get origin sha: %w", err)
+	}
+	status, err := r.cachedStatus(ctx, sha)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all release status: %w", err)
+		return nil, nil, err
+	}
+	releaseList := status.applicationList()
+	if err := releaser.AddPullRequestStatus(ctx, r.Api, releaseList); err != nil {
+		return nil, nil, fmt.Errorf("failed to get pull request status: %w", err)
 	}
-	var ret releaser_protobuf.GetAllApplicationStatusResponse
+	ret := make([]*releaser_protobuf.ApplicationStatus, 0, len(releaseList.Application))
 	for _, app := range releaseList.Application {
 		appStatus := &releaser_protobuf.ApplicationStatus{
-			Name: app.Name,
+			Name:       app.Name,
+			Repository: r.ID,
 		}
 		for _, rc := range app.ReleaseCandidate {
 			appStatus.ReleaseStatus = append(appStatus.ReleaseStatus, &releaser_protobuf.ReleaseStatus{
-				Name:     rc.Name,
-				PrNumber: rc.ExistingPR,
-				Status:   statusAsProto(rc.Status),
+				Name:        rc.Name,
+				PrNumber:    rc.ExistingPR,
+				Status:      statusAsProto(rc.Status),
+				PullRequest: pullRequestAsProto(rc.PullRequest),
 			})
 		}
-		ret.ApplicationStatus = append(ret.ApplicationStatus, appStatus)
+		ret = append(ret, appStatus)
 	}
-	return &ret, nil
+	return ret, status.asProto(r.ID), nil
 }
 
 func statusAsProto(status releaser.ReleaseCandidateStatus) releaser_protobuf.ReleaseStatus_Status {
@@ -162,4 +490,45 @@ func statusAsProto(status releaser.ReleaseCandidateStatus) releaser_protobuf.Rel
 	}
 }
 
+func pullRequestAsProto(pr *releaser.PullRequestDetails) *releaser_protobuf.PullRequestStatus {
+	if pr == nil {
+		return nil
+	}
+	ret := &releaser_protobuf.PullRequestStatus{
+		Approvals: int32(pr.Approvals),
+		Draft:     pr.Draft,
+	}
+	switch pr.State {
+	case releaser.PullRequestStateOpen:
+		ret.State = releaser_protobuf.PullRequestStatus_STATE_OPEN
+	case releaser.PullRequestStateMerged:
+		ret.State = releaser_protobuf.PullRequestStatus_STATE_MERGED
+	case releaser.PullRequestStateClosed:
+		ret.State = releaser_protobuf.PullRequestStatus_STATE_CLOSED
+	}
+	switch pr.ReviewDecision {
+	case releaser.ReviewDecisionApproved:
+		ret.ReviewDecision = releaser_protobuf.PullRequestStatus_REVIEW_DECISION_APPROVED
+	case releaser.ReviewDecisionChangesRequested:
+		ret.ReviewDecision = releaser_protobuf.PullRequestStatus_REVIEW_DECISION_CHANGES_REQUESTED
+	case releaser.ReviewDecisionReviewRequired:
+		ret.ReviewDecision = releaser_protobuf.PullRequestStatus_REVIEW_DECISION_REVIEW_REQUIRED
+	}
+	switch pr.Mergeable {
+	case releaser.MergeableStateMergeable:
+		ret.Mergeable = releaser_protobuf.PullRequestStatus_MERGEABLE_MERGEABLE
+	case releaser.MergeableStateConflicting:
+		ret.Mergeable = releaser_protobuf.PullRequestStatus_MERGEABLE_CONFLICTING
+	}
+	switch pr.Checks {
+	case releaser.CheckStatePending:
+		ret.Checks = releaser_protobuf.PullRequestStatus_CHECKS_PENDING
+	case releaser.CheckStateSuccess:
+		ret.Checks = releaser_protobuf.PullRequestStatus_CHECKS_SUCCESS
+	case releaser.CheckStateFailure:
+		ret.Checks = releaser_protobuf.PullRequestStatus_CHECKS_FAILURE
+	}
+	return ret
+}
+
 var _ releaser_protobuf.Releaser = &Server{}
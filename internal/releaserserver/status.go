@@ -0,0 +1,118 @@
+package releaserserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	releaser_protobuf "github.com/cresta/cresta-releaser/rpc/releaser"
+	"go.uber.org/zap"
+)
+
+// statusSnapshot is the release status of every application at one SHA of the default branch.  It leaves out PRs,
+// which change without the default branch moving.
+type statusSnapshot struct {
+	sha        string
+	computedAt time.Time
+	duration   time.Duration
+	// apps are in the order ListApplications returned them
+	apps []*releaser.Application
+	// recomputed names the applications that were not reused from the previous snapshot
+	recomputed []string
+}
+
+// applicationList copies the snapshot, so PRs can be added to it without changing the cache
+func (s *statusSnapshot) applicationList() *releaser.ApplicationList {
+	ret := &releaser.ApplicationList{
+		Application: make([]releaser.Application, 0, len(s.apps)),
+	}
+	for _, app := range s.apps {
+		c := releaser.Application{Name: app.Name}
+		for _, rc := range app.ReleaseCandidate {
+			rcCopy := *rc
+			c.ReleaseCandidate = append(c.ReleaseCandidate, &rcCopy)
+		}
+		ret.Application = append(ret.Application, c)
+	}
+	return ret
+}
+
+func (s *statusSnapshot) asProto(repository string) *releaser_protobuf.StatusSnapshot {
+	return &releaser_protobuf.StatusSnapshot{
+		Repository:             repository,
+		GitSha:                 s.sha,
+		ComputedAt:             s.computedAt.UTC().Format(time.RFC3339),
+		ComputeDurationMs:      s.duration.Milliseconds(),
+		RecomputedApplications: s.recomputed,
+	}
+}
+
+// cachedStatus returns the status of the repository at sha, computing it if the default branch moved since it was last
+// computed
+func (r *Repository) cachedStatus(ctx context.Context, sha string) (*statusSnapshot, error) {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	if r.status != nil && r.status.sha == sha {
+		return r.status, nil
+	}
+	err := r.Repo.WithSnapshot(ctx, func(snapshot *managedgitrepo.Worktree, snapshotSha string) error {
+		// The snapshot may be newer than sha, if another fetch finished in between
+		if r.status != nil && r.status.sha == snapshotSha {
+			return nil
+		}
+		status, err := computeStatus(ctx, r.Logger, r.apiFor(snapshot), snapshot.G, r.status, snapshotSha)
+		if err != nil {
+			return err
+		}
+		r.status = status
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all release status: %w", err)
+	}
+	return r.status, nil
+}
+
+// computeStatus computes the status of every application at sha.  Applications whose directories did not change since
+// prev are reused from it.
+func computeStatus(ctx context.Context, logger *zap.Logger, api releaser.Api, g releaser.Git, prev *statusSnapshot, sha string) (*statusSnapshot, error) {
+	start := time.Now()
+	names, err := api.ListApplications()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application list: %w", err)
+	}
+	reusable := make(map[string]*releaser.Application)
+	if prev != nil {
+		if files, err := g.ChangedFiles(ctx, prev.sha, sha, "apps"); err != nil {
+			// The previous SHA may be gone after a force push
+			logger.Warn("unable to diff against the previous status, computing every application", zap.String("previous", prev.sha), zap.Error(err))
+		} else if changed, all := releaser.ChangedApplications(files); !all {
+			for _, app := range prev.apps {
+				if !changed[app.Name] {
+					reusable[app.Name] = app
+				}
+			}
+		}
+	}
+	ret := &statusSnapshot{
+		sha:  sha,
+		apps: make([]*releaser.Application, 0, len(names)),
+	}
+	for _, name := range names {
+		app, exists := reusable[name]
+		if !exists {
+			app, err = releaser.ApplicationReleaseStatus(ctx, api, name)
+			if err != nil {
+				return nil, err
+			}
+			ret.recomputed = append(ret.recomputed, name)
+		}
+		ret.apps = append(ret.apps, app)
+	}
+	ret.computedAt = time.Now()
+	ret.duration = ret.computedAt.Sub(start)
+	logger.Info("computed release status", zap.String("sha", sha), zap.Int("recomputed", len(ret.recomputed)), zap.Int("applications", len(names)), zap.Duration("duration", ret.duration))
+	return ret, nil
+}
@@ -0,0 +1,73 @@
+package releaserserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// statusRecorder is an API whose applications each have a single release, and which counts how often each one's
+// releases are listed
+type statusRecorder struct {
+	releaser.Api
+	apps   []string
+	listed map[string]int
+}
+
+func (s *statusRecorder) ListApplications() ([]string, error) {
+	return s.apps, nil
+}
+
+func (s *statusRecorder) ListReleases(application string) ([]string, error) {
+	s.listed[application]++
+	return []string{"01-dev"}, nil
+}
+
+// diffRecorder is a Git that reports the same changed files for any diff
+type diffRecorder struct {
+	releaser.Git
+	changed []string
+	err     error
+}
+
+func (d *diffRecorder) ChangedFiles(_ context.Context, _ string, _ string, _ ...string) ([]string, error) {
+	return d.changed, d.err
+}
+
+func TestComputeStatus(t *testing.T) {
+	ctx := context.Background()
+	api := &statusRecorder{apps: []string{"a1", "a2"}, listed: map[string]int{}}
+	g := &diffRecorder{}
+	first, err := computeStatus(ctx, zap.NewNop(), api, g, nil, "sha1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a1", "a2"}, first.recomputed)
+	require.Equal(t, "sha1", first.asProto("deploy").GitSha)
+
+	// Only the application that changed, and the new one, are computed again
+	api.apps = []string{"a1", "a2", "a3"}
+	g.changed = []string{"apps/a2/releases/01-dev/config.yaml", "README.md"}
+	second, err := computeStatus(ctx, zap.NewNop(), api, g, first, "sha2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a2", "a3"}, second.recomputed)
+	require.Same(t, first.apps[0], second.apps[0])
+	require.Equal(t, map[string]int{"a1": 1, "a2": 2, "a3": 1}, api.listed)
+
+	// PRs are added to a copy, so the cache never holds them
+	list := second.applicationList()
+	list.Application[0].ReleaseCandidate[0].ExistingPR = 5
+	require.Zero(t, second.apps[0].ReleaseCandidate[0].ExistingPR)
+
+	g.changed = []string{"apps/.releaser.yaml"}
+	third, err := computeStatus(ctx, zap.NewNop(), api, g, second, "sha3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a1", "a2", "a3"}, third.recomputed)
+
+	g.err = errors.New("bad object sha3")
+	fourth, err := computeStatus(ctx, zap.NewNop(), api, g, third, "sha4")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a1", "a2", "a3"}, fourth.recomputed)
+}
@@ -0,0 +1,215 @@
+package releaserserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cresta/cresta-releaser/releaser"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxWebhookPayload is the largest payload GitHub delivers
+	maxWebhookPayload = 25 << 20
+	// webhookActionTimeout bounds the follow-up work a single webhook delivery starts
+	webhookActionTimeout = 10 * time.Minute
+)
+
+// WebhookHandler receives GitHub webhooks, so repositories are refreshed as soon as they change instead of on the next
+// poll.  Deliveries are acknowledged once their signature is verified, and the follow-up work runs in the background
+// because GitHub gives up on deliveries after 10 seconds.
+type WebhookHandler struct {
+	server *Server
+	secret []byte
+	// ctx is the lifetime of the server, which bounds follow-up work
+	ctx context.Context
+	wg  sync.WaitGroup
+}
+
+// NewWebhookHandler creates a handler that verifies deliveries were signed with secret
+func NewWebhookHandler(ctx context.Context, server *Server, secret string) (*WebhookHandler, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("a webhook secret is required")
+	}
+	return &WebhookHandler{
+		server: server,
+		secret: []byte(secret),
+		ctx:    ctx,
+	}, nil
+}
+
+// webhookPayload is the part of push, pull_request, pull_request_review and issue_comment payloads the releaser uses
+type webhookPayload struct {
+	Action     string `json:"action"`
+	Ref        string `json:"ref"`
+	Repository struct {
+		Name          string `json:"name"`
+		DefaultBranch string `json:"default_branch"`
+		Owner         struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	} `json:"repository"`
+	PullRequest *struct {
+		Number int64 `json:"number"`
+		Merged bool  `json:"merged"`
+		Head   struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+	} `json:"pull_request"`
+	Issue *struct {
+		Number int64 `json:"number"`
+		// PullRequest is only set when the issue is a PR
+		PullRequest *struct{} `json:"pull_request"`
+	} `json:"issue"`
+	Comment *struct {
+		Body string `json:"body"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"comment"`
+}
+
+var errBadSignature = errors.New("webhook signature does not match")
+
+// verifySignature checks the X-Hub-Signature-256 header of a delivery against its body
+func verifySignature(secret []byte, signature string, body []byte) error {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return errBadSignature
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return errBadSignature
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errBadSignature
+	}
+	return nil
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayload))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+	if err := verifySignature(h.secret, r.Header.Get("X-Hub-Signature-256"), body); err != nil {
+		h.server.Logger.Warn("rejected webhook", zap.Error(err), zap.String("delivery", r.Header.Get("X-GitHub-Delivery")))
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	event := r.Header.Get("X-GitHub-Event")
+	logger := h.server.Logger.With(zap.String("event", event), zap.String("delivery", r.Header.Get("X-GitHub-Delivery")))
+	if event == "ping" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "unable to parse payload", http.StatusBadRequest)
+		return
+	}
+	repo := h.server.repositoryForRemote(payload.Repository.Owner.Login, payload.Repository.Name)
+	if repo == nil {
+		logger.Info("ignoring webhook for unmanaged repository", zap.String("owner", payload.Repository.Owner.Login), zap.String("name", payload.Repository.Name))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	actions := repo.webhookActions(event, &payload)
+	if len(actions) == 0 {
+		h.server.events.refresh()
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		ctx, cancel := context.WithTimeout(h.ctx, webhookActionTimeout)
+		defer cancel()
+		for _, action := range actions {
+			if err := action.run(ctx); err != nil {
+				repo.Logger.Error("webhook action failed", zap.String("event", event), zap.String("action", action.name), zap.Error(err))
+			}
+		}
+		h.server.events.refresh()
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Wait blocks until the follow-up work of every delivery received so far is done
+func (h *WebhookHandler) Wait() {
+	h.wg.Wait()
+}
+
+// webhookAction is a follow-up of a webhook delivery
+type webhookAction struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// webhookActions returns what to do about an event.  PR caches are dropped right away, so lookups made while the
+// follow-up work runs already see the change.
+func (r *Repository) webhookActions(event string, payload *webhookPayload) []webhookAction {
+	refresh := webhookAction{name: "refresh", run: r.refresh}
+	switch event {
+	case "push":
+		r.Repo.Host.ForgetPullRequests()
+		actions := []webhookAction{refresh}
+		if payload.Ref == "refs/heads/"+payload.Repository.DefaultBranch && r.config.ReconcilePullRequests {
+			actions = append(actions, webhookAction{name: "reconcile", run: func(ctx context.Context) error {
+				_, err := r.reconcilePullRequests(ctx, false)
+				return err
+			}})
+		}
+		return actions
+	case "pull_request":
+		r.Repo.Host.ForgetPullRequests()
+		if payload.Action == "closed" && payload.PullRequest != nil && payload.PullRequest.Merged {
+			actions := []webhookAction{refresh}
+			if r.config.Deployments && strings.HasPrefix(payload.PullRequest.Head.Ref, releaser.ReleaseBranchPrefix) {
+				number := payload.PullRequest.Number
+				actions = append(actions, webhookAction{name: "deploy", run: func(ctx context.Context) error {
+					return r.deployPullRequest(ctx, number)
+				}})
+			}
+			return actions
+		}
+	case "pull_request_review":
+		r.Repo.Host.ForgetPullRequests()
+	case "issue_comment":
+		if payload.Action != "created" || payload.Issue == nil || payload.Issue.PullRequest == nil || payload.Comment == nil {
+			return nil
+		}
+		command, ok := parseChatOpsCommand(payload.Comment.Body)
+		if !ok {
+			return nil
+		}
+		number, login := payload.Issue.Number, payload.Comment.User.Login
+		return []webhookAction{{name: "chatops", run: func(ctx context.Context) error {
+			return r.runChatOps(ctx, number, login, command)
+		}}}
+	}
+	return nil
+}
+
+// repositoryForRemote returns the managed repository that lives at owner/name on its code host, or nil if there is none
+func (s *Server) repositoryForRemote(owner string, name string) *Repository {
+	for _, rid := range s.repositoryOrder {
+		r := s.repositories[rid]
+		if r.remote != nil && strings.EqualFold(r.remote.Owner, owner) && strings.EqualFold(r.remote.Name, name) {
+			return r
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package releaserserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cresta/cresta-releaser/internal/managedgitrepo"
+	"github.com/cresta/cresta-releaser/releaser"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// forgetRecorder is a CodeHost that counts how often its PR caches were dropped
+type forgetRecorder struct {
+	releaser.CodeHost
+	forgotten int
+}
+
+func (f *forgetRecorder) ForgetPullRequests() {
+	f.forgotten++
+}
+
+func newWebhookServer(t *testing.T) (*WebhookHandler, *Repository, *forgetRecorder) {
+	host := &forgetRecorder{}
+	repo := &Repository{
+		ID:     "deploy",
+		Logger: zap.NewNop(),
+		Repo:   &managedgitrepo.Repo{Host: host},
+		config: RepositoryConfig{ReconcilePullRequests: true},
+		remote: &releaser.RemoteURL{Host: "github.com", Owner: "cresta", Name: "deploy"},
+	}
+	s := &Server{
+		Logger:          zap.NewNop(),
+		repositories:    map[string]*Repository{repo.ID: repo},
+		repositoryOrder: []string{repo.ID},
+	}
+	h, err := NewWebhookHandler(context.Background(), s, "s3cret")
+	require.NoError(t, err)
+	return h, repo, host
+}
+
+func loadPayload(t *testing.T, name string) []byte {
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return b
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliver(h http.Handler, event string, signature string, body []byte) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	req.Header.Set("X-Hub-Signature-256", signature)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestWebhookSignature(t *testing.T) {
+	h, _, _ := newWebhookServer(t)
+	body := loadPayload(t, "ping.json")
+	require.Equal(t, http.StatusOK, deliver(h, "ping", sign("s3cret", body), body))
+	require.Equal(t, http.StatusUnauthorized, deliver(h, "ping", sign("wrong", body), body))
+	require.Equal(t, http.StatusUnauthorized, deliver(h, "ping", "", body))
+	require.Equal(t, http.StatusUnauthorized, deliver(h, "ping", "sha256=zz", body))
+	tampered := bytes.Replace(body, []byte("Design"), []byte("Plan"), 1)
+	require.Equal(t, http.StatusUnauthorized, deliver(h, "ping", sign("s3cret", body), tampered))
+	_, err := NewWebhookHandler(context.Background(), h.server, "")
+	require.Error(t, err)
+}
+
+func TestWebhookActions(t *testing.T) {
+	h, repo, host := newWebhookServer(t)
+	actionNames := func(event string, payload string) []string {
+		var p webhookPayload
+		require.NoError(t, json.Unmarshal(loadPayload(t, payload), &p))
+		require.Equal(t, repo, h.server.repositoryForRemote(p.Repository.Owner.Login, p.Repository.Name))
+		var ret []string
+		for _, a := range repo.webhookActions(event, &p) {
+			ret = append(ret, a.name)
+		}
+		return ret
+	}
+	require.Equal(t, []string{"refresh", "reconcile"}, actionNames("push", "push.json"))
+	require.Equal(t, []string{"refresh"}, actionNames("pull_request", "pull_request_closed.json"))
+	require.Empty(t, actionNames("pull_request_review", "pull_request_review.json"))
+	require.Equal(t, []string{"chatops"}, actionNames("issue_comment", "issue_comment.json"))
+	require.Equal(t, 3, host.forgotten)
+	repo.config.Deployments = true
+	require.Equal(t, []string{"refresh", "deploy"}, actionNames("pull_request", "pull_request_closed.json"))
+	host.forgotten = 3
+
+	// Deliveries without follow-up work are done by the time they are acknowledged
+	body := loadPayload(t, "pull_request_review.json")
+	require.Equal(t, http.StatusOK, deliver(h, "pull_request_review", sign("s3cret", body), body))
+	require.Equal(t, 4, host.forgotten)
+	repo.remote = &releaser.RemoteURL{Host: "github.com", Owner: "cresta", Name: "other"}
+	require.Equal(t, http.StatusOK, deliver(h, "pull_request_review", sign("s3cret", body), body))
+	require.Equal(t, 4, host.forgotten)
+}
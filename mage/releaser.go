@@ -24,7 +24,7 @@ func MustGetInstance() releaser.Api {
 		if mg.Verbose() {
 			l.Level.SetLevel(zap.DebugLevel)
 		}
-		logger, err := l.Build()
+		logger, err := l.Build(zap.WrapCore(releaser.RedactingCore))
 		if err != nil {
 			panic(err)
 		}
@@ -164,11 +164,20 @@ func CommitForRelease(ctx context.Context, application string, release string) e
 }
 
 // ForcePushCurrentBranch will force push the current branch to the remote repository as a branch with the same name.
-// Fails on branches master or main.
+// Fails on branches master, main, or the default branch.
 func ForcePushCurrentBranch(ctx context.Context) error {
 	return MustGetInstance().ForcePushCurrentBranch(ctx)
 }
 
+// Promote promotes a release with a pull request, or onto the default branch if the release is in direct mode
+func Promote(ctx context.Context, application string, release string) error {
+	result, err := releaser.Promote(ctx, MustGetInstance(), application, release)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, result)
+}
+
 // PullRequestCurrent creates a pull request for the current branch
 func PullRequestCurrent(ctx context.Context) error {
 	pr, err := MustGetInstance().PullRequestCurrent(ctx)
@@ -189,6 +198,29 @@ func CheckForPROnCurrentBranch(ctx context.Context) error {
 	return nil
 }
 
+// EnableAutoMerge makes GitHub merge a pull request with method (merge, squash or rebase) once reviews and checks pass
+func EnableAutoMerge(ctx context.Context, prNumber int64, method string) error {
+	return MustGetInstance().EnableAutoMergeForCurrentRemote(ctx, prNumber, releaser.MergeMethod(method))
+}
+
+// ReconcilePullRequests closes promotion pull requests that are no longer needed and deletes their branches
+func ReconcilePullRequests(ctx context.Context, dryRun bool) error {
+	closed, err := MustGetInstance().ReconcilePullRequests(ctx, dryRun)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, closed)
+}
+
+// PullRequestDetails prints the state, review decision, mergeability and checks of a pull request
+func PullRequestDetails(ctx context.Context, prNumber int64) error {
+	details, err := MustGetInstance().PullRequestDetails(ctx, prNumber)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, details)
+}
+
 // GithubWhoami returns who the CLI thinks you are on github
 func GithubWhoami(ctx context.Context) error {
 	s, err := MustGetInstance().GithubWhoami(ctx)
@@ -207,3 +239,36 @@ func ApprovePullRequestForCurrentRemote(ctx context.Context, approvalMessage str
 func MergePullRequestForCurrentRemote(ctx context.Context, prNumber int64) error {
 	return MustGetInstance().MergePullRequestForCurrentRemote(ctx, prNumber)
 }
+
+// PromotionHistory prints the promotions recorded in the commits of ref, newest first.  An empty application prints
+// the promotions of every application, and a limit of zero prints every promotion.
+func PromotionHistory(ctx context.Context, ref string, application string, limit int) error {
+	history, err := MustGetInstance().PromotionHistory(ctx, ref, application, limit)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, history)
+}
+
+// DeployPullRequest creates a GitHub deployment for a merged promotion pull request
+func DeployPullRequest(ctx context.Context, prNumber int64) error {
+	deployment, err := MustGetInstance().DeployPullRequest(ctx, prNumber)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, deployment)
+}
+
+// Deployments prints the most recent GitHub deployments of a release, newest first
+func Deployments(ctx context.Context, application string, release string) error {
+	deployments, err := MustGetInstance().Deployments(ctx, application, release)
+	if err != nil {
+		return err
+	}
+	return getOutputFormat().WriteObject(os.Stdout, deployments)
+}
+
+// SetDeploymentStatus reports on the progress of a GitHub deployment
+func SetDeploymentStatus(ctx context.Context, deploymentID int64, state string, description string) error {
+	return MustGetInstance().SetDeploymentStatus(ctx, deploymentID, releaser.DeploymentState(state), description)
+}
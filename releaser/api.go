@@ -3,8 +3,8 @@ package releaser
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -21,10 +21,19 @@ import (
 // FromCommandLine will process our API using command line execution. It assumes you have things like `Git` already
 // installed.
 type FromCommandLine struct {
-	Fs     FileSystem
-	Git    Git
-	Github GitHub
-	Logger *zap.Logger
+	Fs       FileSystem
+	Git      Git
+	CodeHost CodeHost
+	Logger   *zap.Logger
+	// CommitThroughGithub creates release commits with the GitHub API instead of git, so GitHub signs and verifies
+	// them as the authenticated user or app.  Requires CodeHost to be GitHub.
+	CommitThroughGithub bool
+	// CommitMessageTemplate overrides DefaultCommitMessageTemplate
+	CommitMessageTemplate string
+	// Actor is recorded as who asked for promotions.  Defaults to the git author.
+	Actor string
+	// OverwriteForeignCommits allows pushes to destroy commits on the remote branch that the releaser did not create
+	OverwriteForeignCommits bool
 }
 
 const emptyKustomizeFile = `apiVersion: kustomize.config.k8s.io/v1beta1
@@ -122,13 +131,30 @@ func CheckForPRForRelease(ctx context.Context, a Api, application string, releas
 	return a.CheckForPRForBranch(ctx, DefaultBranchNameForRelease(application, release))
 }
 
+// ReleasePullRequests lists every open PR on a releaser branch with a single sequence of queries, keyed by branch
+func (f *FromCommandLine) ReleasePullRequests(ctx context.Context) (map[string]PullRequestSummary, error) {
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	prs, err := f.CodeHost.ListPullRequests(ctx, owner, repo, ReleaseBranchPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	ret := make(map[string]PullRequestSummary, len(prs))
+	for _, pr := range prs {
+		ret[pr.HeadRefName] = pr
+	}
+	return ret, nil
+}
+
 func (f *FromCommandLine) CheckForPRForBranch(ctx context.Context, branchName string) (int64, error) {
 	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get remote as Github repo: %w", err)
+		return 0, fmt.Errorf("failed to get remote repo: %w", err)
 	}
 
-	pr, err := f.Github.FindPRForBranch(ctx, owner, repo, branchName)
+	pr, err := f.CodeHost.FindPRForBranch(ctx, owner, repo, branchName)
 	if err != nil {
 		return 0, fmt.Errorf("failed to find pr for branch: %w", err)
 	}
@@ -215,23 +241,198 @@ func (f *FromCommandLine) CreateChildApplication(parent string, child string) er
 	return nil
 }
 
+// ErrChecksFailing is returned when merging a PR whose status checks are failing
+var ErrChecksFailing = errors.New("pull request checks are failing")
+
+// ErrPullRequestNotOpen is returned when approving or merging a PR that was already merged or closed
+var ErrPullRequestNotOpen = errors.New("pull request is not open")
+
+// ErrSelfApproval is returned when a PR would be approved by the user who opened it, or who asked for the promotion
+// it makes
+var ErrSelfApproval = errors.New("pull requests cannot be approved by their author")
+
+// ErrSelfUnavailable is returned by CodeHost.Self when the credentials cannot look up who they belong to, as is the case
+// for GitHub Apps
+var ErrSelfUnavailable = errors.New("code host user of the credentials is unknown")
+
+// UnmergeableReason is why a PR cannot be merged
+type UnmergeableReason string
+
+const (
+	UnmergeableNotOpen        UnmergeableReason = "not_open"
+	UnmergeableConflicting    UnmergeableReason = "conflicting"
+	UnmergeableChecksFailing  UnmergeableReason = "checks_failing"
+	UnmergeableReviewRequired UnmergeableReason = "review_required"
+)
+
+// UnmergeableError is returned when merging a PR the code host would refuse to merge.  errors.Is matches
+// ErrPullRequestNotOpen and ErrChecksFailing for those reasons.
+type UnmergeableError struct {
+	Number  int64
+	Reason  UnmergeableReason
+	Details *PullRequestDetails
+}
+
+func (e *UnmergeableError) Error() string {
+	switch e.Reason {
+	case UnmergeableNotOpen:
+		return fmt.Sprintf("cannot merge PR %d: it is %s", e.Number, e.Details.State)
+	case UnmergeableConflicting:
+		return fmt.Sprintf("cannot merge PR %d: it conflicts with its base branch", e.Number)
+	case UnmergeableChecksFailing:
+		return fmt.Sprintf("refusing to merge PR %d: %s", e.Number, ErrChecksFailing)
+	case UnmergeableReviewRequired:
+		return fmt.Sprintf("cannot merge PR %d: its review decision is %s", e.Number, e.Details.ReviewDecision)
+	default:
+		return fmt.Sprintf("cannot merge PR %d: %s", e.Number, e.Reason)
+	}
+}
+
+func (e *UnmergeableError) Is(target error) bool {
+	switch target {
+	case ErrPullRequestNotOpen:
+		return e.Reason == UnmergeableNotOpen
+	case ErrChecksFailing:
+		return e.Reason == UnmergeableChecksFailing
+	default:
+		return false
+	}
+}
+
+// CheckMergeable returns an *UnmergeableError if details show a PR that cannot be merged.  Mergeability the code host
+// has not worked out yet, and pending checks, do not count against the PR.
+func CheckMergeable(details *PullRequestDetails) error {
+	reason := UnmergeableReason("")
+	switch {
+	case details.State != PullRequestStateOpen:
+		reason = UnmergeableNotOpen
+	case details.Mergeable == MergeableStateConflicting:
+		reason = UnmergeableConflicting
+	case details.Checks == CheckStateFailure:
+		reason = UnmergeableChecksFailing
+	case details.ReviewDecision == ReviewDecisionChangesRequested || details.ReviewDecision == ReviewDecisionReviewRequired:
+		reason = UnmergeableReviewRequired
+	default:
+		return nil
+	}
+	return &UnmergeableError{
+		Number:  details.Number,
+		Reason:  reason,
+		Details: details,
+	}
+}
+
 func (f *FromCommandLine) MergePullRequestForCurrentRemote(ctx context.Context, prNumber int64) error {
 	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get remote as Github repo: %w", err)
+		return fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	details, err := f.CodeHost.PullRequestDetails(ctx, owner, repo, prNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get details of PR %d: %w", prNumber, err)
+	}
+	if err := CheckMergeable(details); err != nil {
+		return err
+	}
+	return f.CodeHost.MergePullRequest(ctx, owner, repo, prNumber)
+}
+
+func (f *FromCommandLine) EnableAutoMergeForCurrentRemote(ctx context.Context, prNumber int64, method MergeMethod) error {
+	gh, ok := f.CodeHost.(GitHub)
+	if !ok {
+		return fmt.Errorf("auto-merge is only supported on GitHub")
+	}
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get remote repo: %w", err)
 	}
-	return f.Github.MergePullRequest(ctx, owner, repo, prNumber)
+	return gh.EnablePullRequestAutoMerge(ctx, owner, repo, prNumber, method)
+}
+
+func (f *FromCommandLine) PullRequestDetails(ctx context.Context, prNumber int64) (*PullRequestDetails, error) {
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	return f.CodeHost.PullRequestDetails(ctx, owner, repo, prNumber)
 }
 
 func (f *FromCommandLine) ApprovePullRequestForCurrentRemote(ctx context.Context, approvalMessage string, prNumber int64) error {
 	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get remote as Github repo: %w", err)
+		return fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	if approvalMessage == "" {
+		approvalMessage = "Approved by cresta-releaser"
+	}
+	return f.CodeHost.AcceptPullRequest(ctx, approvalMessage, owner, repo, prNumber)
+}
+
+// ApprovePullRequestAs approves a PR on behalf of approver, who is named in the review.  It fails with ErrSelfApproval if
+// approver opened the PR or asked for the promotion it makes, or if the code host user the review is made as opened it,
+// since code hosts refuse that.
+func (f *FromCommandLine) ApprovePullRequestAs(ctx context.Context, approver string, approvalMessage string, prNumber int64) error {
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	details, err := f.CodeHost.PullRequestDetails(ctx, owner, repo, prNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get details of PR %d: %w", prNumber, err)
+	}
+	if details.State != PullRequestStateOpen {
+		return fmt.Errorf("cannot approve PR %d: it is %s: %w", prNumber, details.State, ErrPullRequestNotOpen)
+	}
+	if SameIdentity(approver, details.Author) {
+		return fmt.Errorf("%s cannot approve PR %d: %w", approver, prNumber, ErrSelfApproval)
+	}
+	if promotion, ok := ParsePromotion(details.HeadMessage); ok && SameIdentity(approver, promotion.Actor) {
+		return fmt.Errorf("%s asked for the promotion in PR %d, so cannot approve it: %w", approver, prNumber, ErrSelfApproval)
+	}
+	self, err := f.CodeHost.Self(ctx)
+	if err != nil {
+		f.Logger.Warn("unable to find code host user", zap.Error(err))
+		// GitHub Apps cannot look themselves up.  The code host still refuses the review if they opened the PR.
+		if !errors.Is(err, ErrSelfUnavailable) {
+			return fmt.Errorf("failed to find the code host user the review would be made as: %w", err)
+		}
+	} else if SameIdentity(self, details.Author) {
+		return fmt.Errorf("PR %d was opened by %s, who the review would be made as: %w", prNumber, self, ErrSelfApproval)
 	}
 	if approvalMessage == "" {
 		approvalMessage = "Approved by cresta-releaser"
 	}
-	return f.Github.AcceptPullRequest(ctx, approvalMessage, owner, repo, prNumber)
+	approvalMessage = fmt.Sprintf("%s\n\nApproved on behalf of %s", approvalMessage, approver)
+	return f.CodeHost.AcceptPullRequest(ctx, approvalMessage, owner, repo, prNumber)
+}
+
+// SameIdentity returns true if a and b are the same user.  Either may be a login or a git identity such as
+// "Name <email>", whose name and email each match a login.
+func SameIdentity(a string, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	for _, x := range identityParts(a) {
+		for _, y := range identityParts(b) {
+			if strings.EqualFold(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func identityParts(identity string) []string {
+	identity = strings.TrimSpace(identity)
+	open := strings.Index(identity, "<")
+	if open == -1 || !strings.HasSuffix(identity, ">") {
+		return []string{identity}
+	}
+	ret := []string{identity[open+1 : len(identity)-1]}
+	if name := strings.TrimSpace(identity[:open]); name != "" {
+		ret = append(ret, name)
+	}
+	return ret
 }
 
 func (f *FromCommandLine) CheckForPROnCurrentBranch(ctx context.Context) (int64, error) {
@@ -243,7 +444,7 @@ func (f *FromCommandLine) CheckForPROnCurrentBranch(ctx context.Context) (int64,
 }
 
 func (f *FromCommandLine) GithubWhoami(ctx context.Context) (string, error) {
-	return f.Github.Self(ctx)
+	return f.CodeHost.Self(ctx)
 }
 
 func (f *FromCommandLine) PullRequestCurrent(ctx context.Context) (int64, error) {
@@ -255,11 +456,11 @@ func (f *FromCommandLine) PullRequestCurrent(ctx context.Context) (int64, error)
 	if err != nil {
 		return 0, fmt.Errorf("unable to parse remote URL: %w", err)
 	}
-	info, err := f.Github.RepositoryInfo(ctx, owner, repo)
+	baseBranch, err := f.Git.DefaultBranch(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("unable to get repository info for %s/%s: %w", owner, repo, err)
+		return 0, fmt.Errorf("unable to get default branch: %w", err)
 	}
-	if prNum, err := f.Github.CreatePullRequest(ctx, info.Repository.ID, string(info.Repository.DefaultBranchRef.Name), currentBranch, fmt.Sprintf("PR from cresta-releaser for %s", currentBranch), "Deployment"); err != nil {
+	if prNum, err := f.CodeHost.CreatePullRequest(ctx, owner, repo, baseBranch, currentBranch, fmt.Sprintf("PR from cresta-releaser for %s", currentBranch), "Deployment"); err != nil {
 		return 0, fmt.Errorf("unable to create pull request: %w", err)
 	} else {
 		return prNum, nil
@@ -267,23 +468,185 @@ func (f *FromCommandLine) PullRequestCurrent(ctx context.Context) (int64, error)
 }
 
 func (f *FromCommandLine) ForcePushCurrentBranch(ctx context.Context) error {
+	currentBranch, err := f.forcePushableBranch(ctx)
+	if err != nil {
+		return err
+	}
+	return f.pushHead(ctx, currentBranch)
+}
+
+// ForeignCommitsError is returned when a push would destroy commits on the remote branch that the releaser did not
+// create, for example a fixup pushed by a reviewer
+type ForeignCommitsError struct {
+	Branch  string
+	Commits []Commit
+}
+
+func (e *ForeignCommitsError) Error() string {
+	shas := make([]string, 0, len(e.Commits))
+	for _, c := range e.Commits {
+		shas = append(shas, c.Sha)
+	}
+	return fmt.Sprintf("branch %s has commits not created by the releaser: %s", e.Branch, strings.Join(shas, ", "))
+}
+
+// isReleaserCommit returns true if a commit message was written by the releaser
+func isReleaserCommit(message string) bool {
+	if _, ok := ParsePromotion(message); ok {
+		return true
+	}
+	// Commits from before promotion trailers existed
+	return strings.HasPrefix(message, "cresta-releaser: ")
+}
+
+// pushHead force pushes HEAD to branch, unless the remote branch moved since the releaser last pushed it and the new
+// commits were not created by the releaser.  The push is leased against the remote SHA that was checked, so changes
+// that race with the push are not overwritten either.
+func (f *FromCommandLine) pushHead(ctx context.Context, branch string) error {
+	remoteSha, err := f.Git.RemoteBranchSha(ctx, branch)
+	if err != nil {
+		return fmt.Errorf("failed to get remote sha of %s: %w", branch, err)
+	}
+	if remoteSha != "" && !f.OverwriteForeignCommits {
+		if err := f.checkForeignCommits(ctx, branch, remoteSha); err != nil {
+			return err
+		}
+	}
+	if err := f.Git.PushHeadWithLease(ctx, "origin", branch, remoteSha); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+	sha, err := f.Git.CurrentGitSha(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current git sha: %w", err)
+	}
+	return f.Git.RecordPushedSha(ctx, branch, sha)
+}
+
+func (f *FromCommandLine) checkForeignCommits(ctx context.Context, branch string, remoteSha string) error {
+	lastPushed, err := f.Git.LastPushedSha(ctx, branch)
+	if err != nil {
+		return fmt.Errorf("failed to get last pushed sha of %s: %w", branch, err)
+	}
+	if lastPushed == remoteSha {
+		return nil
+	}
+	// Someone else moved the branch.  That is fine as long as every commit on it came from a releaser.
+	foreign, err := f.foreignCommits(ctx, branch, remoteSha)
+	if err != nil {
+		return err
+	}
+	if len(foreign) > 0 {
+		return &ForeignCommitsError{
+			Branch:  branch,
+			Commits: foreign,
+		}
+	}
+	return nil
+}
+
+// foreignCommits returns the commits on the remote branch, which is at remoteSha, that the releaser did not create
+func (f *FromCommandLine) foreignCommits(ctx context.Context, branch string, remoteSha string) ([]Commit, error) {
+	if err := f.Git.FetchBranch(ctx, branch); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", branch, err)
+	}
+	defaultBranch, err := f.Git.DefaultBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default branch: %w", err)
+	}
+	commits, err := f.Git.Log(ctx, fmt.Sprintf("origin/%s..%s", defaultBranch, remoteSha), 0, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits on %s: %w", branch, err)
+	}
+	var foreign []Commit
+	for _, c := range commits {
+		if !isReleaserCommit(c.Message) {
+			foreign = append(foreign, c)
+		}
+	}
+	return foreign, nil
+}
+
+// forcePushableBranch returns the current branch, if it is safe to overwrite on the remote
+func (f *FromCommandLine) forcePushableBranch(ctx context.Context) (string, error) {
 	currentBranch, err := f.Git.CurrentBranchName(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get current branch: %w", err)
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+	defaultBranch, err := f.Git.DefaultBranch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get default branch: %w", err)
 	}
-	if currentBranch == "master" || currentBranch == "main" {
-		return fmt.Errorf("cannot force push master or main branch")
+	if currentBranch == "master" || currentBranch == "main" || currentBranch == defaultBranch {
+		return "", fmt.Errorf("cannot force push master, main, or the default branch %s", defaultBranch)
 	}
-	return f.Git.ForcePushHead(ctx, "origin", currentBranch)
+	return currentBranch, nil
 }
 
 func (f *FromCommandLine) CommitForRelease(ctx context.Context, application string, release string) error {
-	msg := fmt.Sprintf("cresta-releaser: %s:%s", application, release)
+	promotion, err := f.promotionForRelease(ctx, application, release)
+	if err != nil {
+		return fmt.Errorf("failed to describe promotion: %w", err)
+	}
+	msg, err := promotion.CommitMessage(f.CommitMessageTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to create commit message: %w", err)
+	}
+	if f.CommitThroughGithub {
+		return f.commitThroughGithub(ctx, msg)
+	}
 	return f.Git.CommitAll(ctx, msg)
 }
 
+// commitThroughGithub commits every local change with the GitHub API.  The current branch is pushed as is first,
+// since the API can only add commits to a branch that already exists on the remote.  Afterwards, the local branch
+// points at the commit GitHub created.
+func (f *FromCommandLine) commitThroughGithub(ctx context.Context, message string) error {
+	gh, ok := f.CodeHost.(GitHub)
+	if !ok {
+		return fmt.Errorf("commits can only be created through the code host on GitHub")
+	}
+	branch, err := f.forcePushableBranch(ctx)
+	if err != nil {
+		return err
+	}
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to parse remote URL: %w", err)
+	}
+	changes, err := f.Git.StagedChanges(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list changes to commit: %w", err)
+	}
+	for _, a := range changes.Additions {
+		if a.Mode == "120000" || a.Mode == "160000" {
+			return fmt.Errorf("cannot commit %s through GitHub: only regular files are supported", a.Path)
+		}
+	}
+	parent, err := f.Git.CurrentGitSha(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current git sha: %w", err)
+	}
+	if err := f.pushHead(ctx, branch); err != nil {
+		return fmt.Errorf("failed to push parent commit to %s: %w", branch, err)
+	}
+	sha, err := gh.CreateCommitOnBranch(ctx, owner, repo, branch, parent, message, changes)
+	if err != nil {
+		return fmt.Errorf("failed to create commit through GitHub: %w", err)
+	}
+	if err := f.Git.FetchBranch(ctx, branch); err != nil {
+		return fmt.Errorf("failed to fetch commit created through GitHub: %w", err)
+	}
+	if err := f.Git.ResetHard(ctx, sha); err != nil {
+		return fmt.Errorf("failed to reset to commit created through GitHub: %w", err)
+	}
+	return f.Git.RecordPushedSha(ctx, branch, sha)
+}
+
+// ReleaseBranchPrefix starts the name of every branch the releaser creates for a promotion
+const ReleaseBranchPrefix = "releaser-"
+
 func DefaultBranchNameForRelease(application string, release string) string {
-	return fmt.Sprintf("releaser-%s-%s", application, release)
+	return fmt.Sprintf("%s%s-%s", ReleaseBranchPrefix, application, release)
 }
 
 func (f *FromCommandLine) FreshGitBranch(ctx context.Context, application string, release string, forcedName string) error {
@@ -338,12 +701,8 @@ func (f *FromCommandLine) ApplyRelease(application string, release string, oldRe
 }
 
 func (f *FromCommandLine) isReleaseSymlink(application string, release string) bool {
-	releaseDirectory := filepath.Join("apps", application, "releases", release)
-	fi, err := os.Stat(releaseDirectory)
-	if err != nil {
-		return false
-	}
-	return fi.Mode()&os.ModeSymlink == os.ModeSymlink
+	isSymlink, err := f.Fs.IsSymlink(filepath.Join("apps", application, "releases", release))
+	return err == nil && isSymlink
 }
 
 type searchReplace struct {
@@ -370,10 +729,28 @@ type ReleaseConfigMetadata struct {
 	} `yaml:"currentRelease,omitempty"`
 }
 
+// ReleaseMode is how promotions into a release reach the default branch
+type ReleaseMode string
+
+const (
+	// ReleaseModePullRequest opens a pull request for each promotion.  This is the default.
+	ReleaseModePullRequest ReleaseMode = "pr"
+	// ReleaseModeDirect commits each promotion straight onto the default branch
+	ReleaseModeDirect ReleaseMode = "direct"
+)
+
 type ReleaseConfig struct {
 	SearchReplace      []searchReplace       `yaml:"searchReplace,omitempty"`
 	RegexSearchReplace []regexSearchReplace  `yaml:"regexSearchReplace,omitempty"`
 	Metadata           ReleaseConfigMetadata `yaml:"metadata,omitempty"`
+	// Mode is how promotions into this release are made.  It is only read from the release's own .releaser.yaml.
+	Mode ReleaseMode `yaml:"mode,omitempty"`
+	// AutoMerge, if set, enables GitHub auto-merge with this merge method on promotion PRs into this release.  It is
+	// only read from the release's own .releaser.yaml.
+	AutoMerge MergeMethod `yaml:"autoMerge,omitempty"`
+	// Environment is the GitHub deployment environment of this release.  It is only read from the release's own
+	// .releaser.yaml, and defaults to the release name without its ordering prefix.
+	Environment string `yaml:"environment,omitempty"`
 }
 
 func (c *ReleaseConfig) ApplyToFile(file ReleaseFile, previousReleaseName string, newReleaseName string) (string, error) {
@@ -411,6 +788,36 @@ func (c *ReleaseConfig) mergeFrom(r ReleaseConfig) {
 	c.RegexSearchReplace = append(r.RegexSearchReplace, c.RegexSearchReplace...)
 }
 
+func (f *FromCommandLine) ReleaseMode(application string, release string) (ReleaseMode, error) {
+	cfg, err := ReleaseConfigForRelease(f.Fs, application, release, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to get release config for %s:%s: %w", application, release, err)
+	}
+	if cfg == nil || cfg.Mode == "" {
+		return ReleaseModePullRequest, nil
+	}
+	switch cfg.Mode {
+	case ReleaseModePullRequest, ReleaseModeDirect:
+		return cfg.Mode, nil
+	default:
+		return "", fmt.Errorf("release %s:%s has unknown mode %s", application, release, cfg.Mode)
+	}
+}
+
+func (f *FromCommandLine) ReleaseAutoMerge(application string, release string) (MergeMethod, error) {
+	cfg, err := ReleaseConfigForRelease(f.Fs, application, release, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to get release config for %s:%s: %w", application, release, err)
+	}
+	if cfg == nil || cfg.AutoMerge == "" {
+		return "", nil
+	}
+	if _, err := cfg.AutoMerge.githubv4(); err != nil {
+		return "", fmt.Errorf("release %s:%s has an invalid autoMerge: %w", application, release, err)
+	}
+	return cfg.AutoMerge, nil
+}
+
 func (f *FromCommandLine) PreviewRelease(ctx context.Context, application string, release string, ignoreMetadataFile bool) (oldRelease *Release, newRelease *Release, err error) {
 	f.Logger.Debug("previewing release")
 	defer f.Logger.Debug("previewed release")
@@ -643,20 +1050,34 @@ func (f *FromCommandLine) ListApplications() ([]string, error) {
 	return dirs, nil
 }
 
-func NewFromCommandLine(ctx context.Context, logger *zap.Logger, githubCfg *NewGQLClientConfig) (*FromCommandLine, error) {
-	gh, err := NewGQLClient(ctx, logger, githubCfg)
+// NewFromCommandLine creates an API that operates on the git checkout in the current directory.  Unless cfg says
+// otherwise, the code host is picked from the checkout's origin.
+func NewFromCommandLine(ctx context.Context, logger *zap.Logger, cfg *CodeHostConfig) (*FromCommandLine, error) {
+	g := &GitCli{
+		Logger: logger,
+	}
+	if cfg == nil {
+		cfg = &CodeHostConfig{}
+	}
+	if cfg.Remote == nil {
+		// Not every command runs inside a checkout with a remote, so fall back to the default provider
+		if remote, err := g.GetRemote(ctx); err == nil {
+			hostCfg := *cfg
+			hostCfg.Remote = remote
+			cfg = &hostCfg
+		}
+	}
+	host, err := NewCodeHost(ctx, logger, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Github client: %w", err)
+		return nil, fmt.Errorf("failed to create code host client: %w", err)
 	}
 	return &FromCommandLine{
 		Logger: logger,
 		Fs: &OSFileSystem{
 			Logger: logger,
 		},
-		Git: &GitCli{
-			Logger: logger,
-		},
-		Github: gh,
+		Git:      g,
+		CodeHost: host,
 	}, nil
 }
 
@@ -812,24 +1233,64 @@ type Api interface {
 	// FreshGitBranch will create a fresh Git branch for releasing.  The name of the branch will somewhat match the
 	// release + application name.
 	FreshGitBranch(ctx context.Context, application string, release string, forcedName string) error
-	// CommitForRelease will commit the release to the Git branch.  It assumes you've already called ApplyRelease
+	// CommitForRelease will commit the release to the Git branch, with trailers that describe the promotion.  It
+	// assumes you've already called ApplyRelease.
+	// When committing through GitHub, the branch is pushed as part of the commit.
 	CommitForRelease(ctx context.Context, application string, release string) error
+	// ReleaseMode returns how promotions into a release are made
+	ReleaseMode(application string, release string) (ReleaseMode, error)
+	// ReleaseAutoMerge returns the merge method to enable auto-merge with on promotion PRs into a release, or an empty
+	// string if auto-merge is off
+	ReleaseAutoMerge(application string, release string) (MergeMethod, error)
+	// CommitDirect promotes a release straight onto the default branch, without a pull request.  It returns the SHA of
+	// the pushed commit, or an empty string if the release is already up to date.
+	CommitDirect(ctx context.Context, application string, release string) (string, error)
 	// AreThereUncommittedChanges will check if there are any uncommitted changes in the Git branch.
 	AreThereUncommittedChanges(ctx context.Context) (bool, error)
 	// ForcePushCurrentBranch will force push the current branch to the remote repository as a branch with the same name.
-	// Fails on branches master or main.
+	// Fails on branches master, main, or the default branch.  Fails with a *ForeignCommitsError if the remote branch
+	// has commits the releaser did not create, unless OverwriteForeignCommits is set.
 	ForcePushCurrentBranch(ctx context.Context) error
 	// PullRequestCurrent creates a pull request for the current branch
 	PullRequestCurrent(ctx context.Context) (int64, error)
+	// UpdatePullRequestCurrent updates the body of the existing pull request for the current branch
+	UpdatePullRequestCurrent(ctx context.Context, prNumber int64) error
+	// ReleaseBranchDiffers returns true if the release in the working tree differs from the release on its promotion
+	// branch on origin, ignoring release metadata
+	ReleaseBranchDiffers(ctx context.Context, application string, release string) (bool, error)
 	// CheckForPROnCurrentBranch will check if there is a pull request on the current branch.  Returns 0 if there is no
 	// PR, otherwise the PR number
 	CheckForPROnCurrentBranch(ctx context.Context) (int64, error)
-	// GithubWhoami returns who the CLI thinks you are on Github
+	// GithubWhoami returns who the CLI thinks you are on the code host (GitHub or GitLab)
 	GithubWhoami(ctx context.Context) (string, error)
 	// ApprovePullRequestForCurrentRemote will approve the pull request on the current remote
 	ApprovePullRequestForCurrentRemote(ctx context.Context, approvalMessage string, prNumber int64) error
-	// MergePullRequestForCurrentRemote will merge an approved PR
+	// ApprovePullRequestAs approves a pull request on the current remote on behalf of approver.  Fails with
+	// ErrSelfApproval if approver, or the code host user, authored the PR.
+	ApprovePullRequestAs(ctx context.Context, approver string, approvalMessage string, prNumber int64) error
+	// MergePullRequestForCurrentRemote will merge an approved PR.  Fails with an *UnmergeableError, which matches
+	// ErrChecksFailing if the PR's status checks are failing, when the code host would refuse to merge it.
 	MergePullRequestForCurrentRemote(ctx context.Context, prNumber int64) error
+	// EnableAutoMergeForCurrentRemote makes GitHub merge a PR with method once its reviews and checks pass
+	EnableAutoMergeForCurrentRemote(ctx context.Context, prNumber int64, method MergeMethod) error
+	// ReleaseEnvironment returns the GitHub deployment environment of a release
+	ReleaseEnvironment(application string, release string) (string, error)
+	// DeployPullRequest creates a GitHub deployment for a merged promotion PR
+	DeployPullRequest(ctx context.Context, prNumber int64) (*Deployment, error)
+	// SetDeploymentStatus reports on the progress of a GitHub deployment
+	SetDeploymentStatus(ctx context.Context, deploymentID int64, state DeploymentState, description string) error
+	// Deployments returns the most recent GitHub deployments of a release, newest first
+	Deployments(ctx context.Context, application string, release string) ([]Deployment, error)
+	// PullRequestDetails returns the state, reviews, mergeability and checks of a PR on the current remote
+	PullRequestDetails(ctx context.Context, prNumber int64) (*PullRequestDetails, error)
 	// CheckForPRForBranch returns the PR number for a branch of the current Git repository
 	CheckForPRForBranch(ctx context.Context, branchName string) (int64, error)
+	// ReleasePullRequests returns every open PR on a releaser branch, keyed by branch
+	ReleasePullRequests(ctx context.Context) (map[string]PullRequestSummary, error)
+	// ReconcilePullRequests closes promotion PRs that are no longer needed, and deletes their branches.  With dryRun,
+	// it only reports the PRs it would close.
+	ReconcilePullRequests(ctx context.Context, dryRun bool) ([]ReconciledPullRequest, error)
+	// PromotionHistory returns the promotions recorded in the commits of ref, newest first.  An empty application
+	// returns the promotions of every application.
+	PromotionHistory(ctx context.Context, ref string, application string, limit int) ([]PromotionEvent, error)
 }
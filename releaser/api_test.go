@@ -1,11 +1,17 @@
 package releaser
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"path/filepath"
 	"sigs.k8s.io/yaml"
+	"strings"
 	"testing"
 
+	"github.com/cresta/magehelper/pipe"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestReleaseConfigMergeFrom(t *testing.T) {
@@ -150,3 +156,409 @@ func TestPromotion(t *testing.T) {
 		})
 	})
 }
+
+func TestPromotionHistory(t *testing.T) {
+	ctx := context.Background()
+	NewComplexSetup().WithLayout(ctx, t, func(inst Api) {
+		var stdout bytes.Buffer
+		require.NoError(t, pipe.NewPiped("git", "rev-parse", "HEAD").Execute(ctx, nil, &stdout, nil))
+		sourceSha := strings.TrimSpace(stdout.String())
+		RequireRelease(t, ctx, inst, "a2", "01-staging")
+		require.NoError(t, inst.CommitForRelease(ctx, "a2", "01-staging"))
+		RequireRelease(t, ctx, inst, "a3", "01-staging")
+		require.NoError(t, inst.CommitForRelease(ctx, "a3", "01-staging"))
+
+		history, err := inst.PromotionHistory(ctx, "HEAD", "a2", 0)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, Promotion{
+			Application: "a2",
+			From:        "00-head",
+			To:          "01-staging",
+			SourceSha:   sourceSha,
+			Actor:       "John <example@example.com>",
+		}, history[0].Promotion)
+
+		all, err := inst.PromotionHistory(ctx, "HEAD", "", 0)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.Equal(t, "a3", all[0].Application)
+	})
+	t.Run("parse edited message", func(t *testing.T) {
+		p, ok := ParsePromotion("cresta-releaser: a1:01-staging (#12)\n\n* a commit\n\nReleaser-Application: a1\nReleaser-To: 01-staging\n")
+		require.True(t, ok)
+		require.Equal(t, &Promotion{Application: "a1", To: "01-staging"}, p)
+		_, ok = ParsePromotion("fix a typo")
+		require.False(t, ok)
+	})
+	t.Run("parse only the last paragraph", func(t *testing.T) {
+		revert := "Revert \"cresta-releaser: a1:02-prod\"\n\nReleaser-Application: a1\nReleaser-To: 02-prod\nReleaser-Actor: octocat\n\nThis reverts commit abc.\n"
+		_, ok := ParsePromotion(revert)
+		require.False(t, ok)
+		squashed := "Promote everything (#14)\n\nReleaser-Application: a1\nReleaser-To: 02-prod\n\nReleaser-Application: a2\nReleaser-To: 01-staging\nReleaser-Actor: hubot\nCo-authored-by: Someone <someone@example.com>\n"
+		p, ok := ParsePromotion(squashed)
+		require.True(t, ok)
+		require.Equal(t, &Promotion{Application: "a2", To: "01-staging", Actor: "hubot"}, p)
+		_, ok = ParsePromotion("Promote everything\n\nReleaser-Application: a1\nReleaser-To: 02-prod\nReleaser-Application: a2\n")
+		require.False(t, ok)
+	})
+}
+
+func TestForcePushForeignCommits(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init", "--bare", "--initial-branch", "main").WithDir(upstream))
+	clone := func(name string) (*FromCommandLine, string) {
+		dir := filepath.Join(t.TempDir(), name)
+		MustExec(t, pipe.NewPiped("git", "clone", upstream, dir))
+		MustExec(t, pipe.NewPiped("git", "config", "user.name", name).WithDir(dir))
+		MustExec(t, pipe.NewPiped("git", "config", "user.email", name+"@example.com").WithDir(dir))
+		return &FromCommandLine{
+			Logger: zap.NewNop(),
+			Fs:     &OSFileSystem{Logger: zap.NewNop(), Root: dir},
+			Git:    &GitCli{Logger: zap.NewNop(), Dir: dir, DefaultBranchName: "main"},
+		}, dir
+	}
+	_, humanDir := clone("human")
+	MustExec(t, pipe.NewPiped("git", "commit", "--allow-empty", "-m", "init").WithDir(humanDir))
+	MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(humanDir))
+
+	bot, botDir := clone("bot")
+	require.NoError(t, bot.Git.CheckoutNewBranch(ctx, "releaser-a1-01-staging"))
+	MustExec(t, pipe.NewPiped("git", "commit", "--allow-empty", "-m", "cresta-releaser: a1:01-staging\n\nReleaser-Application: a1\nReleaser-To: 01-staging").WithDir(botDir))
+	require.NoError(t, bot.ForcePushCurrentBranch(ctx))
+	t.Run("repush own commits", func(t *testing.T) {
+		MustExec(t, pipe.NewPiped("git", "commit", "--amend", "--allow-empty", "--no-edit").WithDir(botDir))
+		require.NoError(t, bot.ForcePushCurrentBranch(ctx))
+	})
+	t.Run("foreign commit", func(t *testing.T) {
+		MustExec(t, pipe.NewPiped("git", "fetch", "origin").WithDir(humanDir))
+		MustExec(t, pipe.NewPiped("git", "checkout", "-b", "fixup", "origin/releaser-a1-01-staging").WithDir(humanDir))
+		MustExec(t, pipe.NewPiped("git", "commit", "--allow-empty", "-m", "fix a typo").WithDir(humanDir))
+		MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:releaser-a1-01-staging").WithDir(humanDir))
+
+		err := bot.ForcePushCurrentBranch(ctx)
+		var foreign *ForeignCommitsError
+		require.ErrorAs(t, err, &foreign)
+		require.Len(t, foreign.Commits, 1)
+		require.Contains(t, foreign.Commits[0].Message, "fix a typo")
+
+		bot.OverwriteForeignCommits = true
+		require.NoError(t, bot.ForcePushCurrentBranch(ctx))
+	})
+}
+
+// racingGit pushes someone else's commit right before the first PushHead
+type racingGit struct {
+	*GitCli
+	beforePush func()
+}
+
+func (r *racingGit) PushHead(ctx context.Context, repository string, ref string) error {
+	if r.beforePush != nil {
+		r.beforePush()
+		r.beforePush = nil
+	}
+	return r.GitCli.PushHead(ctx, repository, ref)
+}
+
+func TestPromoteDirect(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init", "--bare", "--initial-branch", "main").WithDir(upstream))
+	clone := func(name string) string {
+		dir := filepath.Join(t.TempDir(), name)
+		MustExec(t, pipe.NewPiped("git", "clone", upstream, dir))
+		MustExec(t, pipe.NewPiped("git", "config", "user.name", name).WithDir(dir))
+		MustExec(t, pipe.NewPiped("git", "config", "user.email", name+"@example.com").WithDir(dir))
+		return dir
+	}
+	humanDir := clone("human")
+	human := &OSFileSystem{Logger: zap.NewNop(), Root: humanDir}
+	require.NoError(t, human.MakeDirectoryAndParents(filepath.Join("apps", "a1", "releases", "00-head")))
+	require.NoError(t, human.MakeDirectoryAndParents(filepath.Join("apps", "a1", "releases", "01-dev")))
+	require.NoError(t, human.CreateFile(filepath.Join("apps", "a1", "releases", "00-head"), "config.yaml", "release 00-head", 0644))
+	require.NoError(t, human.CreateFile(filepath.Join("apps", "a1", "releases", "01-dev"), "config.yaml", "", 0644))
+	require.NoError(t, human.CreateFile(filepath.Join("apps", "a1", "releases", "01-dev"), ".releaser.yaml", "mode: direct\n", 0644))
+	MustExec(t, pipe.NewPiped("git", "add", ".").WithDir(humanDir))
+	MustExec(t, pipe.NewPiped("git", "commit", "-m", "init").WithDir(humanDir))
+	MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(humanDir))
+
+	botDir := clone("bot")
+	g := &racingGit{
+		GitCli: &GitCli{Logger: zap.NewNop(), Dir: botDir, DefaultBranchName: "main"},
+		beforePush: func() {
+			MustExec(t, pipe.NewPiped("git", "commit", "--allow-empty", "-m", "unrelated").WithDir(humanDir))
+			MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(humanDir))
+		},
+	}
+	bot := &FromCommandLine{
+		Logger: zap.NewNop(),
+		Fs:     &OSFileSystem{Logger: zap.NewNop(), Root: botDir},
+		Git:    g,
+	}
+	mode, err := bot.ReleaseMode("a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, ReleaseModeDirect, mode)
+
+	result, err := Promote(ctx, bot, "a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, PROMOTE_STATUS_DIRECT_COMMIT, result.Status)
+	upstreamGit := &GitCli{Logger: zap.NewNop(), Dir: upstream}
+	commits, err := upstreamGit.Log(ctx, "main", 0, "")
+	require.NoError(t, err)
+	require.Len(t, commits, 3)
+	require.Equal(t, result.CommitSha, commits[0].Sha)
+	require.Contains(t, commits[0].Message, "Releaser-To: 01-dev")
+	require.Contains(t, commits[1].Message, "unrelated")
+	content, err := bot.Fs.ReadFile(filepath.Join("apps", "a1", "releases", "01-dev"), "config.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "release 01-dev", string(content))
+	mode, err = bot.ReleaseMode("a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, ReleaseModeDirect, mode)
+
+	result, err = Promote(ctx, bot, "a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, PROMOTE_STATUS_NO_CHANGES, result.Status)
+}
+
+// closeRecorder is a CodeHost that lists a fixed set of PRs and records the ones that are closed
+type closeRecorder struct {
+	CodeHost
+	prs    []PullRequestSummary
+	closed map[int64]string
+}
+
+func (c *closeRecorder) ListPullRequests(_ context.Context, _ string, _ string, _ string) ([]PullRequestSummary, error) {
+	return c.prs, nil
+}
+
+func (c *closeRecorder) ClosePullRequest(_ context.Context, _ string, _ string, number int64, comment string) error {
+	c.closed[number] = comment
+	return nil
+}
+
+func TestReconcilePullRequests(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init", "--bare", "--initial-branch", "main").WithDir(upstream))
+	dir := filepath.Join(t.TempDir(), "checkout")
+	MustExec(t, pipe.NewPiped("git", "clone", upstream, dir))
+	MustExec(t, pipe.NewPiped("git", "config", "user.name", "bot").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "config", "user.email", "bot@example.com").WithDir(dir))
+	fs := &OSFileSystem{Logger: zap.NewNop(), Root: dir}
+	for app, content := range map[string][2]string{
+		"uptodate": {"release 00-head", "release 01-dev"},
+		"pending":  {"release 00-head", ""},
+		"touched":  {"release 00-head", "release 01-dev"},
+	} {
+		for idx, release := range []string{"00-head", "01-dev"} {
+			releaseDir := filepath.Join("apps", app, "releases", release)
+			require.NoError(t, fs.MakeDirectoryAndParents(releaseDir))
+			require.NoError(t, fs.CreateFile(releaseDir, "config.yaml", content[idx], 0644))
+		}
+	}
+	MustExec(t, pipe.NewPiped("git", "add", ".").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "commit", "-m", "init").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(dir))
+	pushBranch := func(branch string, message string) {
+		MustExec(t, pipe.NewPiped("git", "commit", "--allow-empty", "-m", message).WithDir(dir))
+		MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:"+branch).WithDir(dir))
+		MustExec(t, pipe.NewPiped("git", "reset", "--hard", "origin/main").WithDir(dir))
+	}
+	pushBranch("releaser-uptodate-01-dev", "cresta-releaser: uptodate:01-dev\n\nReleaser-Application: uptodate\nReleaser-To: 01-dev")
+	pushBranch("releaser-touched-01-dev", "a human fix")
+
+	host := &closeRecorder{
+		prs: []PullRequestSummary{
+			{Number: 1, HeadRefName: "releaser-uptodate-01-dev"},
+			{Number: 2, HeadRefName: "releaser-pending-01-dev"},
+			{Number: 3, HeadRefName: "releaser-touched-01-dev"},
+			{Number: 4, HeadRefName: "releaser-deleted-01-dev"},
+		},
+		closed: map[int64]string{},
+	}
+	g := &GitCli{Logger: zap.NewNop(), Dir: dir, DefaultBranchName: "main"}
+	// The remote must look like a code host repository, while git still talks to upstream
+	MustExec(t, pipe.NewPiped("git", "remote", "set-url", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "config", "url."+upstream+".insteadOf", "https://github.com/cresta/deploy.git").WithDir(dir))
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Fs:       fs,
+		Git:      g,
+		CodeHost: host,
+	}
+
+	closed, err := f.ReconcilePullRequests(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, closed, 2)
+	require.Empty(t, host.closed)
+
+	var locked []string
+	held := 0
+	lockCtx := WithApplicationLock(ctx, func(application string) func() {
+		locked = append(locked, application)
+		held++
+		return func() { held-- }
+	})
+	closed, err = f.ReconcilePullRequests(lockCtx, false)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"uptodate", "pending", "touched"}, locked)
+	require.Zero(t, held)
+	require.Len(t, closed, 2)
+	require.Equal(t, int64(1), closed[0].Number)
+	require.Equal(t, int64(4), closed[1].Number)
+	require.Contains(t, host.closed[1], "uptodate:01-dev is already up to date")
+	require.Contains(t, host.closed[4], "no longer exists")
+	sha, err := g.RemoteBranchSha(ctx, "releaser-uptodate-01-dev")
+	require.NoError(t, err)
+	require.Empty(t, sha)
+	sha, err = g.RemoteBranchSha(ctx, "releaser-touched-01-dev")
+	require.NoError(t, err)
+	require.NotEmpty(t, sha)
+}
+
+// prUpdateRecorder is a CodeHost where every branch already has an open PR, and that records updated PR bodies
+type prUpdateRecorder struct {
+	CodeHost
+	bodies map[int64]string
+}
+
+func (p *prUpdateRecorder) FindPRForBranch(_ context.Context, _ string, _ string, _ string) (int64, error) {
+	return 7, nil
+}
+
+func (p *prUpdateRecorder) UpdatePullRequestBody(_ context.Context, _ string, _ string, number int64, body string) error {
+	p.bodies[number] = body
+	return nil
+}
+
+func TestPromoteUpdatesExistingPullRequest(t *testing.T) {
+	ctx := context.Background()
+	upstream := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init", "--bare", "--initial-branch", "main").WithDir(upstream))
+	dir := filepath.Join(t.TempDir(), "checkout")
+	MustExec(t, pipe.NewPiped("git", "clone", upstream, dir))
+	MustExec(t, pipe.NewPiped("git", "config", "user.name", "bot").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "config", "user.email", "bot@example.com").WithDir(dir))
+	fs := &OSFileSystem{Logger: zap.NewNop(), Root: dir}
+	headDir := filepath.Join("apps", "a1", "releases", "00-head")
+	devDir := filepath.Join("apps", "a1", "releases", "01-dev")
+	require.NoError(t, fs.MakeDirectoryAndParents(headDir))
+	require.NoError(t, fs.MakeDirectoryAndParents(devDir))
+	require.NoError(t, fs.CreateFile(headDir, "config.yaml", "v1", 0644))
+	require.NoError(t, fs.CreateFile(devDir, "config.yaml", "", 0644))
+	MustExec(t, pipe.NewPiped("git", "add", ".").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "commit", "-m", "init").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(dir))
+	// The remote must look like a code host repository, while git still talks to upstream
+	MustExec(t, pipe.NewPiped("git", "remote", "set-url", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "config", "url."+upstream+".insteadOf", "https://github.com/cresta/deploy.git").WithDir(dir))
+
+	host := &prUpdateRecorder{bodies: map[int64]string{}}
+	g := &GitCli{Logger: zap.NewNop(), Dir: dir, DefaultBranchName: "main"}
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Fs:       fs,
+		Git:      g,
+		CodeHost: host,
+	}
+	branchContent := func() string {
+		var stdout bytes.Buffer
+		require.NoError(t, pipe.NewPiped("git", "show", "releaser-a1-01-dev:apps/a1/releases/01-dev/config.yaml").WithDir(upstream).Execute(ctx, nil, &stdout, nil))
+		return stdout.String()
+	}
+
+	result, err := Promote(ctx, f, "a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, PROMOTE_STATUS_UPDATED_PULL_REQUEST, result.Status)
+	require.Equal(t, int64(7), result.PullRequest)
+	require.Equal(t, "v1", branchContent())
+
+	delete(host.bodies, 7)
+	result, err = Promote(ctx, f, "a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, PROMOTE_STATUS_EXISTING_PULL_REQUEST, result.Status)
+	require.Empty(t, host.bodies)
+
+	MustExec(t, pipe.NewPiped("git", "checkout", "-B", "main", "origin/main").WithDir(dir))
+	require.NoError(t, fs.CreateFile(headDir, "config.yaml", "v2", 0644))
+	MustExec(t, pipe.NewPiped("git", "commit", "-am", "bump").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "push", "origin", "HEAD:main").WithDir(dir))
+	baseSha, err := g.RevParse(ctx, "HEAD")
+	require.NoError(t, err)
+
+	result, err = Promote(ctx, f, "a1", "01-dev")
+	require.NoError(t, err)
+	require.Equal(t, PROMOTE_STATUS_UPDATED_PULL_REQUEST, result.Status)
+	require.Equal(t, "v2", branchContent())
+	require.Contains(t, host.bodies[7], "from main at "+baseSha)
+}
+
+// listRecorder is a CodeHost that can only list PRs, so anything that looks PRs up one at a time fails
+type listRecorder struct {
+	CodeHost
+	prs   []PullRequestSummary
+	calls int
+}
+
+func (l *listRecorder) ListPullRequests(_ context.Context, _ string, _ string, headPrefix string) ([]PullRequestSummary, error) {
+	l.calls++
+	if headPrefix != ReleaseBranchPrefix {
+		return nil, fmt.Errorf("unexpected prefix %s", headPrefix)
+	}
+	return l.prs, nil
+}
+
+func TestGetAllReleaseStatusListsPullRequestsOnce(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "remote", "add", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	fs := &OSFileSystem{Logger: zap.NewNop(), Root: dir}
+	for _, app := range []string{"a1", "a2", "a3"} {
+		for _, release := range []string{"00-head", "01-dev"} {
+			releaseDir := filepath.Join("apps", app, "releases", release)
+			require.NoError(t, fs.MakeDirectoryAndParents(releaseDir))
+			require.NoError(t, fs.CreateFile(releaseDir, "config.yaml", "release 00-head", 0644))
+		}
+	}
+	require.NoError(t, fs.CreateFile(filepath.Join("apps", "a3", "releases", "01-dev"), "config.yaml", "release 01-dev", 0644))
+	host := &listRecorder{
+		prs: []PullRequestSummary{
+			{Number: 1, HeadRefName: "releaser-a1-01-dev", Details: &PullRequestDetails{Number: 1, State: PullRequestStateOpen}},
+			{Number: 2, HeadRefName: "releaser-a3-01-dev", Details: &PullRequestDetails{Number: 2, State: PullRequestStateOpen}},
+		},
+	}
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Fs:       fs,
+		Git:      &GitCli{Logger: zap.NewNop(), Dir: dir},
+		CodeHost: host,
+	}
+	status, err := GetAllReleaseStatus(ctx, f)
+	require.NoError(t, err)
+	require.Equal(t, 1, host.calls)
+	require.Len(t, status.Application, 3)
+	a1 := status.Application[0].ReleaseCandidate[1]
+	require.Equal(t, RC_STATUS_PENDING, a1.Status)
+	require.Equal(t, int64(1), a1.ExistingPR)
+	require.Equal(t, PullRequestStateOpen, a1.PullRequest.State)
+	a2 := status.Application[1].ReleaseCandidate[1]
+	require.Equal(t, RC_STATUS_PENDING, a2.Status)
+	require.Zero(t, a2.ExistingPR)
+	require.Nil(t, a2.PullRequest)
+	// Releases that need no promotion do not report the PRs left on their branch
+	a3 := status.Application[2].ReleaseCandidate[1]
+	require.Equal(t, RC_STATUS_RELEASED, a3.Status)
+	require.Zero(t, a3.ExistingPR)
+}
+
+func TestChangedApplications(t *testing.T) {
+	apps, all := ChangedApplications([]string{"apps/a1/releases/01-dev/config.yaml", "apps/a2/.releaser.yaml", "README.md"})
+	require.False(t, all)
+	require.Equal(t, map[string]bool{"a1": true, "a2": true}, apps)
+	_, all = ChangedApplications([]string{"apps/a1/releases/01-dev/config.yaml", "apps/.releaser.yaml"})
+	require.True(t, all)
+}
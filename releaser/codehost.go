@@ -0,0 +1,184 @@
+package releaser
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// CodeHost is the service that hosts the remote repository.  It manages pull requests, which GitLab calls merge
+// requests.  Repositories are identified by owner and name; on GitLab the owner is the full group path.
+type CodeHost interface {
+	// CreatePullRequest creates a PR from the remote branch headRefName into baseRefName.  It assumes the remote branch
+	// already exists.
+	CreatePullRequest(ctx context.Context, owner string, name string, baseRefName string, headRefName string, title string, body string) (int64, error)
+	// FindPRForBranch returns the open PR for this branch, or 0 if there is none
+	FindPRForBranch(ctx context.Context, owner string, name string, branch string) (int64, error)
+	// ListPullRequests returns every open PR whose head branch starts with headPrefix
+	ListPullRequests(ctx context.Context, owner string, name string, headPrefix string) ([]PullRequestSummary, error)
+	// UpdatePullRequestBody replaces the description of a PR
+	UpdatePullRequestBody(ctx context.Context, owner string, name string, number int64, body string) error
+	// ClosePullRequest closes a PR without merging it, after leaving comment on it
+	ClosePullRequest(ctx context.Context, owner string, name string, number int64, comment string) error
+	// PullRequestDetails returns the state, reviews, mergeability and checks of a PR
+	PullRequestDetails(ctx context.Context, owner string, name string, number int64) (*PullRequestDetails, error)
+	// Self returns the current user
+	Self(ctx context.Context) (string, error)
+	// AcceptPullRequest approves a PR
+	AcceptPullRequest(ctx context.Context, approvalmessage string, owner string, name string, number int64) error
+	// MergePullRequest merges in a PR and closes it, but only if it's approved
+	MergePullRequest(ctx context.Context, owner string, name string, number int64) error
+	// DefaultBranch returns the name of the default branch of a repository
+	DefaultBranch(ctx context.Context, owner string, name string) (string, error)
+	// GetAccessToken returns a token git can use to authenticate with the host over https
+	GetAccessToken(ctx context.Context) (string, error)
+	// AccessTokens returns the cache GetAccessToken reads from, for refreshing tokens ahead of time, invalidating
+	// rejected tokens and reporting their health
+	AccessTokens() *TokenCache
+	// ForgetPullRequests drops cached PR lookups, for when the host reports that PRs changed
+	ForgetPullRequests()
+}
+
+type PullRequestSummary struct {
+	Number      int64  `json:"number"`
+	HeadRefName string `json:"head_ref_name"`
+	// Details is set by code hosts that can list them along with the PR.  Otherwise, ask for them with
+	// PullRequestDetails.
+	Details *PullRequestDetails `json:"details,omitempty"`
+}
+
+type PullRequestState string
+
+const (
+	PullRequestStateOpen   PullRequestState = "open"
+	PullRequestStateMerged PullRequestState = "merged"
+	PullRequestStateClosed PullRequestState = "closed"
+)
+
+type ReviewDecision string
+
+const (
+	// ReviewDecisionNone means the PR does not need a review
+	ReviewDecisionNone             ReviewDecision = ""
+	ReviewDecisionApproved         ReviewDecision = "approved"
+	ReviewDecisionChangesRequested ReviewDecision = "changes_requested"
+	ReviewDecisionReviewRequired   ReviewDecision = "review_required"
+)
+
+type MergeableState string
+
+const (
+	// MergeableStateUnknown means the host has not finished checking for conflicts yet
+	MergeableStateUnknown     MergeableState = "unknown"
+	MergeableStateMergeable   MergeableState = "mergeable"
+	MergeableStateConflicting MergeableState = "conflicting"
+)
+
+// CheckState is the combined state of every status check (CI) on the head commit of a PR
+type CheckState string
+
+const (
+	// CheckStateNone means there are no checks
+	CheckStateNone    CheckState = ""
+	CheckStatePending CheckState = "pending"
+	CheckStateSuccess CheckState = "success"
+	CheckStateFailure CheckState = "failure"
+)
+
+type PullRequestDetails struct {
+	Number int64            `json:"number"`
+	State  PullRequestState `json:"state"`
+	// Author is the login of the user who opened the PR
+	Author         string         `json:"author,omitempty"`
+	ReviewDecision ReviewDecision `json:"review_decision,omitempty"`
+	// Approvals counts the reviewers whose latest review approves the PR
+	Approvals int            `json:"approvals"`
+	Mergeable MergeableState `json:"mergeable"`
+	Checks    CheckState     `json:"checks,omitempty"`
+	// Draft is true while the PR is on hold, and cannot be merged
+	Draft bool `json:"draft,omitempty"`
+	// HeadMessage is the message of the last commit on the PR's branch
+	HeadMessage string `json:"head_message,omitempty"`
+}
+
+func (p *PullRequestDetails) String() string {
+	ret := fmt.Sprintf("#%d %s mergeable=%s approvals=%d", p.Number, p.State, p.Mergeable, p.Approvals)
+	if p.ReviewDecision != ReviewDecisionNone {
+		ret += " review=" + string(p.ReviewDecision)
+	}
+	if p.Checks != CheckStateNone {
+		ret += " checks=" + string(p.Checks)
+	}
+	if p.Draft {
+		ret += " draft"
+	}
+	return ret
+}
+
+func (p *PullRequestDetails) MarshalText() (text []byte, err error) {
+	return []byte(p.String()), nil
+}
+
+const (
+	ProviderGithub = "github"
+	ProviderGitlab = "gitlab"
+)
+
+// DefaultCodeHostProvider forces the provider picked by NewCodeHost when the config does not set one
+var DefaultCodeHostProvider = os.Getenv("RELEASER_CODE_HOST")
+
+type CodeHostConfig struct {
+	// Provider is ProviderGithub or ProviderGitlab.  Empty picks one from the host of Remote.
+	Provider string
+	// Remote is the origin of the repository.  It may be nil if it is unknown.
+	Remote *RemoteURL
+	Github *NewGQLClientConfig
+	Gitlab *GitlabConfig
+}
+
+// NewCodeHost creates the client for the host of a repository
+func NewCodeHost(ctx context.Context, logger *zap.Logger, cfg *CodeHostConfig) (CodeHost, error) {
+	if cfg == nil {
+		cfg = &CodeHostConfig{}
+	}
+	provider := cfg.provider()
+	switch provider {
+	case ProviderGithub:
+		githubCfg := NewGQLClientConfig{}
+		if cfg.Github != nil {
+			githubCfg = *cfg.Github
+		}
+		if githubCfg.Host == "" && cfg.Remote != nil {
+			githubCfg.Host = cfg.Remote.Host
+		}
+		return NewGQLClient(ctx, logger, &githubCfg)
+	case ProviderGitlab:
+		return NewGitlabClient(logger, cfg.Gitlab, cfg.Remote)
+	default:
+		return nil, fmt.Errorf("unknown code host provider %s", provider)
+	}
+}
+
+func (c *CodeHostConfig) provider() string {
+	if c.Provider != "" {
+		return c.Provider
+	}
+	if DefaultCodeHostProvider != "" {
+		return DefaultCodeHostProvider
+	}
+	if c.Remote == nil {
+		return ProviderGithub
+	}
+	if strings.Contains(c.Remote.Host, "gitlab") {
+		return ProviderGitlab
+	}
+	gitlabCfg := mergeGitlabConfigs(c.Gitlab, &DefaultGitlabConfig)
+	if u, err := url.Parse(gitlabCfg.BaseURL); err == nil && u.Hostname() != "" && strings.EqualFold(u.Hostname(), c.Remote.Host) {
+		return ProviderGitlab
+	}
+	return ProviderGithub
+}
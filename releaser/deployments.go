@@ -0,0 +1,201 @@
+package releaser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DeploymentState is the state of a GitHub deployment status
+type DeploymentState string
+
+const (
+	DeploymentStateQueued     DeploymentState = "queued"
+	DeploymentStatePending    DeploymentState = "pending"
+	DeploymentStateInProgress DeploymentState = "in_progress"
+	DeploymentStateSuccess    DeploymentState = "success"
+	DeploymentStateFailure    DeploymentState = "failure"
+	DeploymentStateError      DeploymentState = "error"
+	DeploymentStateInactive   DeploymentState = "inactive"
+)
+
+func (s DeploymentState) Validate() error {
+	switch s {
+	case DeploymentStateQueued, DeploymentStatePending, DeploymentStateInProgress, DeploymentStateSuccess, DeploymentStateFailure, DeploymentStateError, DeploymentStateInactive:
+		return nil
+	default:
+		return fmt.Errorf("unknown deployment state %s", s)
+	}
+}
+
+// DeploymentPayload is what the releaser attaches to the deployments it creates
+type DeploymentPayload struct {
+	Application string `json:"application"`
+	// Release is the release that was promoted into
+	Release string `json:"release"`
+	// From is the release that was promoted from
+	From string `json:"from,omitempty"`
+	// OriginalSha is the git SHA the promoted content was originally released at
+	OriginalSha string `json:"original_sha,omitempty"`
+	PullRequest int64  `json:"pull_request,omitempty"`
+}
+
+type DeploymentRequest struct {
+	// Ref is the commit that was deployed
+	Ref         string
+	Environment string
+	Description string
+	Payload     DeploymentPayload
+}
+
+type Deployment struct {
+	ID          int64             `json:"id"`
+	Sha         string            `json:"sha"`
+	Environment string            `json:"environment"`
+	Payload     DeploymentPayload `json:"payload"`
+	// State is the state of the latest status of the deployment, if it has any
+	State     DeploymentState `json:"state,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (d *Deployment) MarshalText() (text []byte, err error) {
+	state := d.State
+	if state == "" {
+		state = "none"
+	}
+	return []byte(fmt.Sprintf("%d %s %s:%s %s %s", d.ID, d.Environment, d.Payload.Application, d.Payload.Release, d.Sha, state)), nil
+}
+
+// MergedPullRequest is how a PR was merged
+type MergedPullRequest struct {
+	Number int64
+	Merged bool
+	// MergeCommitSha is the commit the PR was merged as, if it is merged
+	MergeCommitSha string
+	HeadRefName    string
+	// HeadMessage is the message of the last commit on the PR's branch
+	HeadMessage string
+}
+
+var releaseOrderPrefix = regexp.MustCompile(`^[0-9]+-`)
+
+// ReleaseEnvironment returns the deployment environment of a release.  It defaults to the release name without its
+// ordering prefix, so 01-staging is deployed to staging.
+func (f *FromCommandLine) ReleaseEnvironment(application string, release string) (string, error) {
+	cfg, err := ReleaseConfigForRelease(f.Fs, application, release, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to get release config for %s:%s: %w", application, release, err)
+	}
+	if cfg != nil && cfg.Environment != "" {
+		return cfg.Environment, nil
+	}
+	return releaseOrderPrefix.ReplaceAllString(release, ""), nil
+}
+
+// githubForCurrentRemote returns the GitHub client and repository of origin
+func (f *FromCommandLine) githubForCurrentRemote(ctx context.Context, feature string) (GitHub, string, string, error) {
+	gh, ok := f.CodeHost.(GitHub)
+	if !ok {
+		return nil, "", "", fmt.Errorf("%s is only supported on GitHub", feature)
+	}
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	return gh, owner, repo, nil
+}
+
+// DeployPullRequest creates a GitHub deployment for a merged promotion PR, in the environment of the release it
+// promoted into.  The deployment starts in progress, for whatever rolls the change out to report on.  If the PR already
+// has a deployment, that one is returned instead.
+func (f *FromCommandLine) DeployPullRequest(ctx context.Context, prNumber int64) (*Deployment, error) {
+	gh, owner, repo, err := f.githubForCurrentRemote(ctx, "deployments")
+	if err != nil {
+		return nil, err
+	}
+	pr, err := gh.MergedPullRequest(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR %d: %w", prNumber, err)
+	}
+	if !pr.Merged {
+		return nil, fmt.Errorf("PR %d is not merged", prNumber)
+	}
+	promotion, ok := ParsePromotion(pr.HeadMessage)
+	if !ok {
+		return nil, fmt.Errorf("PR %d is not a promotion", prNumber)
+	}
+	environment, err := f.ReleaseEnvironment(promotion.Application, promotion.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment of %s:%s: %w", promotion.Application, promotion.To, err)
+	}
+	// Webhooks are redelivered, and the CLI may deploy a PR the server already deployed
+	existing, err := gh.ListDeployments(ctx, owner, repo, environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments to %s: %w", environment, err)
+	}
+	for _, d := range existing {
+		if d.Payload.PullRequest == prNumber && d.Payload.Application == promotion.Application {
+			f.Logger.Info("PR was already deployed", zap.Int64("pr", prNumber), zap.Int64("deployment", d.ID))
+			return &d, nil
+		}
+	}
+	deployment, err := gh.CreateDeployment(ctx, owner, repo, &DeploymentRequest{
+		Ref:         pr.MergeCommitSha,
+		Environment: environment,
+		Description: fmt.Sprintf("Promote %s from %s to %s", promotion.Application, promotion.From, promotion.To),
+		Payload: DeploymentPayload{
+			Application: promotion.Application,
+			Release:     promotion.To,
+			From:        promotion.From,
+			OriginalSha: promotion.SourceSha,
+			PullRequest: prNumber,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deployment for PR %d: %w", prNumber, err)
+	}
+	description := fmt.Sprintf("Merged PR #%d", prNumber)
+	if err := gh.CreateDeploymentStatus(ctx, owner, repo, deployment.ID, DeploymentStateInProgress, description); err != nil {
+		return nil, fmt.Errorf("failed to set status of deployment %d: %w", deployment.ID, err)
+	}
+	deployment.State = DeploymentStateInProgress
+	return deployment, nil
+}
+
+func (f *FromCommandLine) SetDeploymentStatus(ctx context.Context, deploymentID int64, state DeploymentState, description string) error {
+	if err := state.Validate(); err != nil {
+		return err
+	}
+	gh, owner, repo, err := f.githubForCurrentRemote(ctx, "deployments")
+	if err != nil {
+		return err
+	}
+	return gh.CreateDeploymentStatus(ctx, owner, repo, deploymentID, state, description)
+}
+
+// Deployments returns the most recent deployments of an application's release, newest first
+func (f *FromCommandLine) Deployments(ctx context.Context, application string, release string) ([]Deployment, error) {
+	gh, owner, repo, err := f.githubForCurrentRemote(ctx, "deployments")
+	if err != nil {
+		return nil, err
+	}
+	environment, err := f.ReleaseEnvironment(application, release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment of %s:%s: %w", application, release, err)
+	}
+	deployments, err := gh.ListDeployments(ctx, owner, repo, environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments to %s: %w", environment, err)
+	}
+	// Applications share environments, so only keep the ones the releaser made for this application
+	ret := make([]Deployment, 0, len(deployments))
+	for _, d := range deployments {
+		if d.Payload.Application == application && d.Payload.Release == release {
+			ret = append(ret, d)
+		}
+	}
+	return ret, nil
+}
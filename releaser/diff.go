@@ -0,0 +1,71 @@
+package releaser
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// UnifiedDiff returns a unified diff of every file that differs between two versions of a release.  Paths are relative
+// to the release, prefixed with a/ and b/ like git does.  Files missing from one side are diffed against /dev/null.
+func UnifiedDiff(oldRelease *Release, newRelease *Release) (string, error) {
+	oldFiles, newFiles := oldRelease.FilesByLocation(), newRelease.FilesByLocation()
+	locations := make([]FileLocation, 0, len(oldFiles)+len(newFiles))
+	for loc := range oldFiles {
+		locations = append(locations, loc)
+	}
+	for loc := range newFiles {
+		if _, exists := oldFiles[loc]; !exists {
+			locations = append(locations, loc)
+		}
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		if locations[i].Directory == locations[j].Directory {
+			return locations[i].Name < locations[j].Name
+		}
+		return locations[i].Directory < locations[j].Directory
+	})
+	var b strings.Builder
+	for _, loc := range locations {
+		oldFile, inOld := oldFiles[loc]
+		newFile, inNew := newFiles[loc]
+		if inOld && inNew && oldFile.Content == newFile.Content {
+			continue
+		}
+		name := path.Join(loc.Directory, loc.Name)
+		d := difflib.UnifiedDiff{
+			FromFile: "a/" + name,
+			ToFile:   "b/" + name,
+			Context:  3,
+		}
+		if inOld {
+			d.A = diffLines(oldFile.Content)
+		} else {
+			d.FromFile = "/dev/null"
+		}
+		if inNew {
+			d.B = diffLines(newFile.Content)
+		} else {
+			d.ToFile = "/dev/null"
+		}
+		if err := difflib.WriteUnifiedDiff(&b, d); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// diffLines splits content into lines that each end with a newline, which is what difflib expects
+func diffLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
@@ -0,0 +1,41 @@
+package releaser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	oldRelease := &Release{Files: []ReleaseFile{
+		{Name: "config.yaml", Content: "image: app:v1\nreplicas: 2\n"},
+		{Name: "same.yaml", Content: "unchanged\n"},
+		{Name: "old.yaml", Directory: "extra", Content: "remove me\n"},
+	}}
+	newRelease := &Release{Files: []ReleaseFile{
+		{Name: "same.yaml", Content: "unchanged\n"},
+		{Name: "config.yaml", Content: "image: app:v2\nreplicas: 2\n"},
+		{Name: "new.yaml", Directory: "extra", Content: "add me\n"},
+	}}
+	diff, err := UnifiedDiff(oldRelease, newRelease)
+	require.NoError(t, err)
+	require.Equal(t, `--- a/config.yaml
++++ b/config.yaml
+@@ -1,2 +1,2 @@
+-image: app:v1
++image: app:v2
+ replicas: 2
+--- /dev/null
++++ b/extra/new.yaml
+@@ -0,0 +1 @@
++add me
+--- a/extra/old.yaml
++++ /dev/null
+@@ -1 +0,0 @@
+-remove me
+`, diff)
+
+	diff, err = UnifiedDiff(oldRelease, oldRelease)
+	require.NoError(t, err)
+	require.Empty(t, diff)
+}
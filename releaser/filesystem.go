@@ -21,6 +21,7 @@ type FileSystem interface {
 	ReadFile(dir string, name string) ([]byte, error)
 	FileExists(dir string, name string) (bool, error)
 	MakeDirectoryAndParents(dir string) error
+	IsSymlink(dir string) (bool, error)
 }
 
 func IsGitCheckout(fs FileSystem, dir string) bool {
@@ -59,10 +60,19 @@ type File struct {
 
 type OSFileSystem struct {
 	Logger *zap.Logger
+	// Root is the directory relative paths are resolved against.  Empty means the current working directory.
+	Root string
+}
+
+func (O *OSFileSystem) path(dir string) string {
+	if O.Root == "" || filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(O.Root, dir)
 }
 
 func (O *OSFileSystem) MakeDirectoryAndParents(dir string) error {
-	err := os.MkdirAll(dir, 0755)
+	err := os.MkdirAll(O.path(dir), 0755)
 	if err != nil {
 		return fmt.Errorf("error creating directory %s: %w", dir, err)
 	}
@@ -70,7 +80,7 @@ func (O *OSFileSystem) MakeDirectoryAndParents(dir string) error {
 }
 
 func (O *OSFileSystem) FileExists(dir string, name string) (bool, error) {
-	stats, err := os.Stat(filepath.Join(dir, name))
+	stats, err := os.Stat(O.path(filepath.Join(dir, name)))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return false, nil
@@ -81,7 +91,7 @@ func (O *OSFileSystem) FileExists(dir string, name string) (bool, error) {
 }
 
 func (O *OSFileSystem) ReadFile(dir string, name string) ([]byte, error) {
-	return ioutil.ReadFile(filepath.Join(dir, name))
+	return ioutil.ReadFile(O.path(filepath.Join(dir, name)))
 }
 
 func (O *OSFileSystem) CreateDirectory(dir string) error {
@@ -92,7 +102,7 @@ func (O *OSFileSystem) CreateDirectory(dir string) error {
 	if exists {
 		return nil
 	}
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := os.MkdirAll(O.path(dir), 0755); err != nil {
 		return fmt.Errorf("error creating directory %s: %w", dir, err)
 	}
 	return nil
@@ -100,7 +110,7 @@ func (O *OSFileSystem) CreateDirectory(dir string) error {
 
 func (O *OSFileSystem) DeleteFile(dir string, name string) error {
 	O.Logger.Debug("deleting file", zap.String("dir", dir), zap.String("name", name))
-	if err := os.Remove(filepath.Join(dir, name)); err != nil {
+	if err := os.Remove(O.path(filepath.Join(dir, name))); err != nil {
 		return fmt.Errorf("error deleting file %s: %s", name, err)
 	}
 	return nil
@@ -108,7 +118,7 @@ func (O *OSFileSystem) DeleteFile(dir string, name string) error {
 
 func (O *OSFileSystem) ModifyFileContent(dir string, name string, content string) error {
 	O.Logger.Debug("modifying file content", zap.String("dir", dir), zap.String("name", name))
-	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+	if err := ioutil.WriteFile(O.path(filepath.Join(dir, name)), []byte(content), 0644); err != nil {
 		return fmt.Errorf("error modifying file %s: %s", name, err)
 	}
 	return nil
@@ -116,7 +126,7 @@ func (O *OSFileSystem) ModifyFileContent(dir string, name string, content string
 
 func (O *OSFileSystem) CreateFile(dir string, name string, content string, perms os.FileMode) error {
 	O.Logger.Debug("creating file", zap.String("dir", dir), zap.String("name", name))
-	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), perms); err != nil {
+	if err := ioutil.WriteFile(O.path(filepath.Join(dir, name)), []byte(content), perms); err != nil {
 		return fmt.Errorf("error creating file %s: %s", name, err)
 	}
 	return nil
@@ -124,7 +134,7 @@ func (O *OSFileSystem) CreateFile(dir string, name string, content string, perms
 
 func (O *OSFileSystem) ChangeFileMode(dir string, name string, perms os.FileMode) error {
 	O.Logger.Debug("changing file mode", zap.String("dir", dir), zap.String("name", name))
-	if err := os.Chmod(filepath.Join(dir, name), perms); err != nil {
+	if err := os.Chmod(O.path(filepath.Join(dir, name)), perms); err != nil {
 		return fmt.Errorf("error changing file mode for file %s: %s", name, err)
 	}
 	return nil
@@ -139,7 +149,7 @@ func (O *OSFileSystem) FilesInsideDirectory(dir string) ([]File, error) {
 	if !exists {
 		return nil, fmt.Errorf("directory %s does not exist", dir)
 	}
-	ents, err := os.ReadDir(dir)
+	ents, err := os.ReadDir(O.path(dir))
 	if err != nil {
 		return nil, fmt.Errorf("error reading directory %s: %s", dir, err)
 	}
@@ -152,7 +162,7 @@ func (O *OSFileSystem) FilesInsideDirectory(dir string) ([]File, error) {
 		if err != nil {
 			return nil, fmt.Errorf("error getting file info for %s: %s", ent.Name(), err)
 		}
-		b, err := ioutil.ReadFile(filepath.Join(dir, ent.Name()))
+		b, err := ioutil.ReadFile(O.path(filepath.Join(dir, ent.Name())))
 		if err != nil {
 			return nil, fmt.Errorf("error reading file %s: %s", ent.Name(), err)
 		}
@@ -166,9 +176,20 @@ func (O *OSFileSystem) FilesInsideDirectory(dir string) ([]File, error) {
 	return ret, nil
 }
 
+func (O *OSFileSystem) IsSymlink(dir string) (bool, error) {
+	fi, err := os.Lstat(O.path(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error getting file stats for %s: %w", dir, err)
+	}
+	return fi.Mode()&os.ModeSymlink == os.ModeSymlink, nil
+}
+
 func (O *OSFileSystem) DirectoryExists(dir string) (bool, error) {
 	O.Logger.Debug("checking if directory exists", zap.String("dir", dir))
-	f, err := os.Stat(dir)
+	f, err := os.Stat(O.path(dir))
 	if err == nil {
 		if f.IsDir() {
 			return true, nil
@@ -183,7 +204,7 @@ func (O *OSFileSystem) DirectoryExists(dir string) (bool, error) {
 
 func (O *OSFileSystem) DirectoriesInsideDirectory(dir string) ([]string, error) {
 	O.Logger.Debug("getting directories inside directory", zap.String("dir", dir))
-	ents, err := os.ReadDir(dir)
+	ents, err := os.ReadDir(O.path(dir))
 	if err != nil {
 		return nil, fmt.Errorf("error reading directory %s: %s", dir, err)
 	}
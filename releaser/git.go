@@ -3,7 +3,10 @@ package releaser
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -17,8 +20,19 @@ type Git interface {
 	CheckoutNewBranch(ctx context.Context, branch string) error
 	CommitAll(ctx context.Context, message string) error
 	CurrentBranchName(ctx context.Context) (string, error)
-	ForcePushHead(ctx context.Context, repository string, ref string) error
+	// PushHeadWithLease force pushes HEAD to the branch ref of repository, but only if the remote branch is still at
+	// expectedSha.  An empty expectedSha requires that the remote branch does not exist.  Fails with
+	// ErrRemoteBranchChanged if the remote branch moved.
+	PushHeadWithLease(ctx context.Context, repository string, ref string, expectedSha string) error
+	// PushHead pushes HEAD to the branch ref of repository without force.  Fails with ErrNonFastForward if the remote
+	// branch has commits HEAD does not.
+	PushHead(ctx context.Context, repository string, ref string) error
+	// Rebase replays the commits of HEAD on top of onto.  A rebase that conflicts is aborted.
+	Rebase(ctx context.Context, onto string) error
+	// GetRemoteAsGithubRepo returns the owner and name of the origin repository
 	GetRemoteAsGithubRepo(ctx context.Context) (string, string, error)
+	// GetRemote returns the parsed URL of origin
+	GetRemote(ctx context.Context) (*RemoteURL, error)
 	CloneURL(ctx context.Context, url string, into string) error
 	ResetClean(ctx context.Context) error
 	FetchAllFromRemote(ctx context.Context) error
@@ -30,6 +44,96 @@ type Git interface {
 	SetLocalAuthor(ctx context.Context, name string, email string) error
 	ForceRemoteRefresh(ctx context.Context) error
 	CurrentGitSha(ctx context.Context) (string, error)
+	// DefaultBranch returns the name of the branch releases are cut from, without the remote prefix
+	DefaultBranch(ctx context.Context) (string, error)
+	// SetRemoteDefaultBranch records branch as the default branch of origin (refs/remotes/origin/HEAD)
+	SetRemoteDefaultBranch(ctx context.Context, branch string) error
+	// RevParse returns the full SHA that ref points to
+	RevParse(ctx context.Context, ref string) (string, error)
+	// CheckoutDetached forcefully checks out ref without a branch, discarding local changes
+	CheckoutDetached(ctx context.Context, ref string) error
+	// AddWorktree creates a new detached worktree at path, checked out at ref
+	AddWorktree(ctx context.Context, path string, ref string) error
+	// RemoveWorktree removes the worktree at path, discarding any changes inside it
+	RemoveWorktree(ctx context.Context, path string) error
+	// PruneWorktrees cleans up information about worktrees that no longer exist on disk
+	PruneWorktrees(ctx context.Context) error
+	// StagedChanges stages every change in the working tree and returns the files that differ from HEAD
+	StagedChanges(ctx context.Context) (*FileChanges, error)
+	// FetchBranch updates refs/remotes/origin/<branch> from origin
+	FetchBranch(ctx context.Context, branch string) error
+	// ResetHard points the current branch at ref, discarding local changes
+	ResetHard(ctx context.Context, ref string) error
+	// AuthorIdentity returns the "Name <email>" new commits are authored as
+	AuthorIdentity(ctx context.Context) (string, error)
+	// Log returns up to limit commits in the history of ref, newest first.  A limit of zero returns every commit.
+	// If grep is not empty, only commits with a message matching the regular expression grep are returned.
+	Log(ctx context.Context, ref string, limit int, grep string) ([]Commit, error)
+	// RemoteBranchSha returns the SHA of branch on origin, or an empty string if origin has no such branch
+	RemoteBranchSha(ctx context.Context, branch string) (string, error)
+	// LastPushedSha returns the SHA recorded by RecordPushedSha for branch, or an empty string if there is none
+	LastPushedSha(ctx context.Context, branch string) (string, error)
+	// RecordPushedSha remembers that sha was pushed to branch
+	RecordPushedSha(ctx context.Context, branch string, sha string) error
+	// ChangedFiles returns the paths that differ between the commits from and to, limited to paths if any are given.
+	// An empty to compares with the working tree, including new files.
+	ChangedFiles(ctx context.Context, from string, to string, paths ...string) ([]string, error)
+	// DeleteRemoteBranch deletes branch from origin, and forgets the SHA recorded for it by RecordPushedSha
+	DeleteRemoteBranch(ctx context.Context, branch string) error
+	// ApplySparseCheckout limits the checkout to the configured sparse patterns, or checks out every file if there are
+	// none
+	ApplySparseCheckout(ctx context.Context) error
+}
+
+// DefaultSparseCheckout are the sparse checkout patterns that include everything the releaser reads: the apps
+// directory, and .releaser.yaml files anywhere in the repository
+var DefaultSparseCheckout = []string{"/apps/", ".releaser.yaml"}
+
+// ErrRemoteBranchChanged is returned when a push is rejected because the remote branch is not where we expected
+var ErrRemoteBranchChanged = errors.New("remote branch changed since it was last fetched")
+
+// ErrNonFastForward is returned when a push is rejected because the remote branch has commits we do not
+var ErrNonFastForward = errors.New("remote branch has commits that are not in HEAD")
+
+// Commit is a single commit in git history
+type Commit struct {
+	Sha     string
+	Time    time.Time
+	Message string
+}
+
+// FileChanges are the files a commit would add, modify or delete, relative to the root of the repository
+type FileChanges struct {
+	Additions []FileAddition
+	Deletions []string
+}
+
+// FileAddition is a file a commit adds or modifies
+type FileAddition struct {
+	Path     string
+	Contents []byte
+	// Mode is the git file mode, for example 100644 for regular files and 120000 for symlinks
+	Mode string
+}
+
+// CommitSigning configures how GitCli signs the commits it creates
+type CommitSigning struct {
+	// Format is the value of gpg.format: openpgp (the default), x509 or ssh
+	Format string
+	// Key is the value of user.signingkey: a GPG key ID, or the path to an SSH key when Format is ssh.  Empty uses the
+	// key git is already configured with.
+	Key string
+}
+
+func (c *CommitSigning) configArgs() []string {
+	var ret []string
+	if c.Format != "" {
+		ret = append(ret, "-c", "gpg.format="+c.Format)
+	}
+	if c.Key != "" {
+		ret = append(ret, "-c", "user.signingkey="+c.Key)
+	}
+	return ret
 }
 
 type refreshInterval struct {
@@ -70,13 +174,94 @@ func (i *refreshInterval) AlwaysExecute(ctx context.Context, f func(ctx context.
 }
 
 type GitCli struct {
-	Logger       *zap.Logger
-	fetchRefresh refreshInterval
+	Logger *zap.Logger
+	// Dir is the directory git commands run inside of.  Empty means the current working directory.
+	Dir string
+	// DefaultBranchName overrides the default branch of origin.  Empty means detect it from refs/remotes/origin/HEAD.
+	DefaultBranchName string
+	// Signing signs the commits made by CommitAll.  Nil leaves signing up to the git config (commit.gpgsign).
+	Signing *CommitSigning
+	// PartialCloneFilter is passed to git clone --filter.  For example, blob:none fetches file contents on demand.
+	PartialCloneFilter string
+	// SparseCheckout limits clones and worktrees to these gitignore style patterns.  Empty checks out every file.
+	SparseCheckout []string
+	// Credentials returns the token git authenticates to origin with over https.  It is called before every command
+	// that talks to origin, so the token may rotate.  Nil leaves authentication up to the git config.
+	Credentials func(ctx context.Context) (string, error)
+	// InvalidateCredentials is told about a token from Credentials that origin rejected.  Commands that fail because
+	// of it are retried once.
+	InvalidateCredentials func(token string)
+	fetchRefresh          refreshInterval
+}
+
+func (g *GitCli) git(args ...string) *pipe.PipedCmd {
+	return pipe.NewPiped("git", args...).WithDir(g.Dir)
+}
+
+// credentialHelper answers git's credential requests with the token in RELEASER_GIT_TOKEN, so the token is never
+// written to the git config or visible in the command line
+const credentialHelper = `!f() { test "$1" = get && echo username=x-access-token && echo "password=${RELEASER_GIT_TOKEN}"; }; f`
+
+// credentialArgs returns the git arguments and environment that authenticate a command with a fresh token from
+// Credentials, and the token itself
+func (g *GitCli) credentialArgs(ctx context.Context) ([]string, []string, string, error) {
+	if g.Credentials == nil {
+		return nil, nil, "", nil
+	}
+	token, err := g.Credentials(ctx)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to get git credentials: %w", err)
+	}
+	AddSecret(token)
+	// The empty helper drops helpers configured elsewhere, which could otherwise answer with other credentials
+	args := []string{"-c", "credential.helper=", "-c", "credential.helper=" + credentialHelper}
+	env := append(os.Environ(), "RELEASER_GIT_TOKEN="+token, "GIT_TERMINAL_PROMPT=0")
+	return args, env, token, nil
+}
+
+// authFailures are what git prints when origin rejects its credentials
+var authFailures = []string{
+	"Authentication failed",
+	"Invalid username or password",
+	"could not read Username",
+	"The requested URL returned error: 401",
+}
+
+func isAuthFailure(stderr string) bool {
+	for _, f := range authFailures {
+		if strings.Contains(stderr, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// runRemote runs a git command that talks to origin.  If origin rejects the token, it is invalidated and the command
+// runs once more with a new one.
+func (g *GitCli) runRemote(ctx context.Context, args ...string) (bytes.Buffer, bytes.Buffer, error) {
+	return g.runWithCredentials(ctx, func(credentialArgs []string) *pipe.PipedCmd {
+		return g.git(append(credentialArgs, args...)...)
+	})
+}
+
+func (g *GitCli) runWithCredentials(ctx context.Context, cmd func(credentialArgs []string) *pipe.PipedCmd) (bytes.Buffer, bytes.Buffer, error) {
+	for attempt := 1; ; attempt++ {
+		credentialArgs, env, token, err := g.credentialArgs(ctx)
+		if err != nil {
+			return bytes.Buffer{}, bytes.Buffer{}, err
+		}
+		stdout, stderr, err := g.runAndLogOutput(ctx, cmd(credentialArgs).WithEnv(env))
+		if err == nil || attempt > 1 || g.InvalidateCredentials == nil || !isAuthFailure(stderr.String()) {
+			return stdout, stderr, err
+		}
+		g.Logger.Warn("origin rejected the git credentials, retrying with a new token")
+		g.InvalidateCredentials(token)
+	}
 }
 
 func (g *GitCli) CurrentGitSha(ctx context.Context) (string, error) {
 	var stdout bytes.Buffer
-	if err := pipe.Shell("git rev-parse --verify HEAD").Execute(ctx, nil, &stdout, nil); err != nil {
+	if err := g.git("rev-parse", "--verify", "HEAD").Execute(ctx, nil, &stdout, nil); err != nil {
 		return "", fmt.Errorf("failed to get current git sha: %w", err)
 	}
 	return strings.TrimSpace(stdout.String()), nil
@@ -88,25 +273,28 @@ func (g *GitCli) ForceRemoteRefresh(ctx context.Context) error {
 
 func (g *GitCli) refreshWithFunction(ctx context.Context, f func(context.Context, func(context.Context) error) error) error {
 	return f(ctx, func(ctx context.Context) error {
-		return pipe.Shell("git fetch --all -v").Run(ctx)
+		if _, stderr, err := g.runRemote(ctx, "fetch", "--all", "-v"); err != nil {
+			return fmt.Errorf("failed to fetch (%s): %w", stderr.String(), err)
+		}
+		return nil
 	})
 }
 
 func (g *GitCli) IsAuthorConfigured(ctx context.Context) (bool, error) {
-	if err := pipe.Shell("git config --get user.name").Run(ctx); err != nil {
+	if err := g.git("config", "--get", "user.name").Run(ctx); err != nil {
 		return false, nil
 	}
-	if err := pipe.Shell("git config --get user.email").Run(ctx); err != nil {
+	if err := g.git("config", "--get", "user.email").Run(ctx); err != nil {
 		return false, nil
 	}
 	return true, nil
 }
 
 func (g *GitCli) SetLocalAuthor(ctx context.Context, name string, email string) error {
-	if err := pipe.NewPiped("git", "config", "user.email", email).Run(ctx); err != nil {
+	if err := g.git("config", "user.email", email).Run(ctx); err != nil {
 		return err
 	}
-	if err := pipe.NewPiped("git", "config", "user.name", name).Run(ctx); err != nil {
+	if err := g.git("config", "user.name", name).Run(ctx); err != nil {
 		return err
 	}
 	return nil
@@ -115,7 +303,7 @@ func (g *GitCli) SetLocalAuthor(ctx context.Context, name string, email string)
 func (g *GitCli) DoesBranchExist(ctx context.Context, branch string) (bool, error) {
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	err := pipe.NewPiped("git", "show-ref", "--quiet", "--verify", "refs/heads/"+branch).Execute(ctx, nil, &stdout, &stderr)
+	err := g.git("show-ref", "--quiet", "--verify", "refs/heads/"+branch).Execute(ctx, nil, &stdout, &stderr)
 	if err != nil {
 		if strings.Contains(stderr.String(), "fatal:") {
 			return false, fmt.Errorf("unable to check if branch exists: %w", err)
@@ -126,33 +314,146 @@ func (g *GitCli) DoesBranchExist(ctx context.Context, branch string) (bool, erro
 }
 
 func (g *GitCli) ForceDeleteLocalBranch(ctx context.Context, branch string) error {
-	return pipe.NewPiped("git", "branch", "-D", branch).Run(ctx)
+	return g.git("branch", "-D", branch).Run(ctx)
 }
 
 func (g *GitCli) ChangeOrigin(ctx context.Context, newOrigin string) error {
-	return pipe.NewPiped("git", "remote", "set-url", "origin", newOrigin).Run(ctx)
+	return g.git("remote", "set-url", "origin", newOrigin).Run(ctx)
+}
+
+func (g *GitCli) DefaultBranch(ctx context.Context) (string, error) {
+	if g.DefaultBranchName != "" {
+		return g.DefaultBranchName, nil
+	}
+	if branch, err := g.remoteHead(ctx); err == nil {
+		return branch, nil
+	}
+	// refs/remotes/origin/HEAD is only created by clone.  Ask the remote for it if it is missing.
+	if _, stderr, err := g.runRemote(ctx, "remote", "set-head", "origin", "--auto"); err != nil {
+		return "", fmt.Errorf("failed to detect default branch of origin (%s): %w", stderr.String(), err)
+	}
+	return g.remoteHead(ctx)
+}
+
+func (g *GitCli) remoteHead(ctx context.Context) (string, error) {
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("symbolic-ref", "--short", "refs/remotes/origin/HEAD"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read refs/remotes/origin/HEAD (%s): %w", stderr.String(), err)
+	}
+	branch := strings.TrimPrefix(strings.TrimSpace(stdout.String()), "origin/")
+	if branch == "" {
+		return "", fmt.Errorf("refs/remotes/origin/HEAD is empty")
+	}
+	return branch, nil
+}
+
+func (g *GitCli) SetRemoteDefaultBranch(ctx context.Context, branch string) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("remote", "set-head", "origin", branch)); err != nil {
+		return fmt.Errorf("failed to set default branch of origin to %s (%s): %w", branch, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) RevParse(ctx context.Context, ref string) (string, error) {
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("rev-parse", "--verify", ref+"^{commit}"))
+	if err != nil {
+		return "", fmt.Errorf("failed to rev-parse %s (%s): %w", ref, stderr.String(), err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func (g *GitCli) CheckoutDetached(ctx context.Context, ref string) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("checkout", "--force", "--detach", ref)); err != nil {
+		return fmt.Errorf("failed to checkout %s (%s): %w", ref, stderr.String(), err)
+	}
+	return g.ResetClean(ctx)
+}
+
+func (g *GitCli) AddWorktree(ctx context.Context, path string, ref string) error {
+	args := []string{"worktree", "add", "--force", "--detach"}
+	if len(g.SparseCheckout) > 0 {
+		// Check out after the sparse patterns are set, so files outside of them are never fetched or written
+		args = append(args, "--no-checkout")
+	}
+	args = append(args, path, ref)
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git(args...)); err != nil {
+		return fmt.Errorf("failed to add worktree %s at %s (%s): %w", path, ref, stderr.String(), err)
+	}
+	if len(g.SparseCheckout) > 0 {
+		return g.sparseCheckoutInto(ctx, path)
+	}
+	return nil
+}
+
+// sparseCheckoutInto sets the sparse patterns of the checkout at dir, then checks out HEAD
+func (g *GitCli) sparseCheckoutInto(ctx context.Context, dir string) error {
+	args := append([]string{"sparse-checkout", "set", "--no-cone"}, g.SparseCheckout...)
+	if _, stderr, err := g.runAndLogOutput(ctx, pipe.NewPiped("git", args...).WithDir(dir)); err != nil {
+		return fmt.Errorf("failed to set sparse checkout of %s (%s): %w", dir, stderr.String(), err)
+	}
+	if _, stderr, err := g.runAndLogOutput(ctx, pipe.NewPiped("git", "reset", "--hard").WithDir(dir)); err != nil {
+		return fmt.Errorf("failed to checkout %s (%s): %w", dir, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) ApplySparseCheckout(ctx context.Context) error {
+	if len(g.SparseCheckout) > 0 {
+		args := append([]string{"sparse-checkout", "set", "--no-cone"}, g.SparseCheckout...)
+		if _, stderr, err := g.runAndLogOutput(ctx, g.git(args...)); err != nil {
+			return fmt.Errorf("failed to set sparse checkout (%s): %w", stderr.String(), err)
+		}
+		return nil
+	}
+	// Only disable sparse checkouts that exist: disabling also rewrites the git config
+	stdout, _, err := g.runAndLogOutput(ctx, g.git("config", "--bool", "core.sparseCheckout"))
+	if err != nil || strings.TrimSpace(stdout.String()) != "true" {
+		return nil
+	}
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("sparse-checkout", "disable")); err != nil {
+		return fmt.Errorf("failed to disable sparse checkout (%s): %w", stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) RemoveWorktree(ctx context.Context, path string) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("worktree", "remove", "--force", path)); err != nil {
+		return fmt.Errorf("failed to remove worktree %s (%s): %w", path, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) PruneWorktrees(ctx context.Context) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("worktree", "prune")); err != nil {
+		return fmt.Errorf("failed to prune worktrees (%s): %w", stderr.String(), err)
+	}
+	return nil
 }
 
 func (g *GitCli) ResetToOriginalBranch(ctx context.Context) error {
 	if err := g.FetchAllFromRemote(ctx); err != nil {
 		return fmt.Errorf("failed to fetch all from remote: %w", err)
 	}
+	defaultBranch, err := g.DefaultBranch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get default branch: %w", err)
+	}
 	currentBranch, err := g.CurrentBranchName(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get current branch name: %w", err)
 	}
-	if currentBranch != "master" {
+	if currentBranch != defaultBranch {
 		// Ignore error because we don't care if the branch doesn't exist
-		_ = pipe.Shell("git branch -D master").Run(ctx)
-		if err := pipe.Shell("git checkout -b master origin/master").Run(ctx); err != nil {
-			return fmt.Errorf("failed to checkout master: %w", err)
+		_ = g.git("branch", "-D", defaultBranch).Run(ctx)
+		if err := g.git("checkout", "-b", defaultBranch, "origin/"+defaultBranch).Run(ctx); err != nil {
+			return fmt.Errorf("failed to checkout %s: %w", defaultBranch, err)
 		}
 	}
 	if err := g.ResetClean(ctx); err != nil {
 		return fmt.Errorf("failed to reset clean: %w", err)
 	}
-	if err := pipe.Shell("git reset --hard origin/master").Run(ctx); err != nil {
-		return fmt.Errorf("failed to reset to origin/master: %w", err)
+	if err := g.git("reset", "--hard", "origin/"+defaultBranch).Run(ctx); err != nil {
+		return fmt.Errorf("failed to reset to origin/%s: %w", defaultBranch, err)
 	}
 	return nil
 }
@@ -162,49 +463,109 @@ func (g *GitCli) FetchAllFromRemote(ctx context.Context) error {
 }
 
 func (g *GitCli) ResetClean(ctx context.Context) error {
-	if err := pipe.Shell("git clean -ffdx").Run(ctx); err != nil {
+	if err := g.git("clean", "-ffdx").Run(ctx); err != nil {
 		return fmt.Errorf("git clean failed: %w", err)
 	}
-	return pipe.Shell("git reset --hard").Run(ctx)
+	return g.git("reset", "--hard").Run(ctx)
 }
 
 func (g *GitCli) CloneURL(ctx context.Context, url string, into string) error {
 	g.Logger.Debug("starting to run command clone")
 	defer g.Logger.Debug("done with command clone")
-	return pipe.NewPiped("git", "clone", url, into).Run(ctx)
+	args := []string{"clone"}
+	if g.PartialCloneFilter != "" {
+		args = append(args, "--filter="+g.PartialCloneFilter)
+	}
+	if len(g.SparseCheckout) > 0 {
+		args = append(args, "--no-checkout")
+	}
+	args = append(args, url, into)
+	// Clone runs outside of Dir, since Dir is usually the location we are cloning into and may not exist yet
+	if _, stderr, err := g.runWithCredentials(ctx, func(credentialArgs []string) *pipe.PipedCmd {
+		return pipe.NewPiped("git", append(credentialArgs, args...)...)
+	}); err != nil {
+		return fmt.Errorf("failed to clone (%s): %w", stderr.String(), err)
+	}
+	if len(g.SparseCheckout) > 0 {
+		return g.sparseCheckoutInto(ctx, into)
+	}
+	return nil
 }
 
 func (g *GitCli) runAndLogOutput(ctx context.Context, cmd *pipe.PipedCmd) (bytes.Buffer, bytes.Buffer, error) {
 	g.Logger.Debug("starting to run command")
 	var stdout, stderr bytes.Buffer
 	err := cmd.Execute(ctx, nil, &stdout, &stderr)
-	g.Logger.Debug("ran command", zap.String("stdout", stdout.String()), zap.String("stderr", stderr.String()))
-	return stdout, stderr, err
+	// stderr ends up in error messages, so scrub any credentials git echoes back
+	var redactedStderr bytes.Buffer
+	redactedStderr.WriteString(Redact(stderr.String()))
+	g.Logger.Debug("ran command", zap.String("stdout", stdout.String()), zap.String("stderr", redactedStderr.String()))
+	return stdout, redactedStderr, err
 }
 
 func (g *GitCli) GetRemoteAsGithubRepo(ctx context.Context) (string, string, error) {
-	g.Logger.Debug("GetRemoteAsGithubRepo")
-	defer g.Logger.Debug("GetRemoteAsGithubRepo done")
-	var stdout, stderr bytes.Buffer
-	stdout, stderr, err := g.runAndLogOutput(ctx, pipe.NewPiped("git", "remote", "get-url", "origin"))
+	remote, err := g.GetRemote(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return remote.Owner, remote.Name, nil
+}
+
+func (g *GitCli) GetRemote(ctx context.Context) (*RemoteURL, error) {
+	g.Logger.Debug("GetRemote")
+	defer g.Logger.Debug("GetRemote done")
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("remote", "get-url", "origin"))
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get remote URL %s: %s", stderr.String(), err)
+		return nil, fmt.Errorf("failed to get remote URL %s: %s", stderr.String(), err)
+	}
+	return ParseRemoteURL(stdout.String())
+}
+
+// RemoteURL is a parsed git remote
+type RemoteURL struct {
+	// Host is the hostname of the remote, without a port.  Empty for remotes on the local filesystem.
+	Host string
+	// Owner is the user, organization or (on GitLab) group path that owns the repository
+	Owner string
+	Name  string
+}
+
+// ParseRemoteURL parses https://, ssh://, and scp like (git@host:owner/name.git) git remotes.  Owner and name are
+// lower case.
+func ParseRemoteURL(remoteURL string) (*RemoteURL, error) {
+	remoteURL = strings.ToLower(strings.TrimSpace(remoteURL))
+	var ret RemoteURL
+	var path string
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse remote URL: %w", err)
+		}
+		ret.Host = u.Hostname()
+		path = u.Path
+	} else if hostPart, pathPart, found := strings.Cut(remoteURL, ":"); found && !strings.Contains(hostPart, "/") {
+		// scp like syntax: [user@]host:path
+		if idx := strings.LastIndex(hostPart, "@"); idx != -1 {
+			hostPart = hostPart[idx+1:]
+		}
+		ret.Host = hostPart
+		path = pathPart
+	} else {
+		path = remoteURL
 	}
-	remoteURL := stdout.String()
-	remoteURL = strings.TrimSpace(remoteURL)
-	remoteURL = strings.ToLower(remoteURL)
-	remoteURL = strings.TrimSuffix(remoteURL, ".git")
-	remoteURL = strings.TrimPrefix(remoteURL, "git@github.com:")
-	parts := strings.Split(remoteURL, "/")
-	if len(parts) < 2 {
-		return "", "", fmt.Errorf("failed to parse remote URL %s", remoteURL)
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return nil, fmt.Errorf("failed to parse remote URL %s", remoteURL)
 	}
-	return parts[len(parts)-2], parts[len(parts)-1], nil
+	ret.Owner = strings.Join(parts[:len(parts)-1], "/")
+	ret.Name = parts[len(parts)-1]
+	return &ret, nil
 }
 
 func (g *GitCli) CurrentBranchName(ctx context.Context) (string, error) {
 	var stdout bytes.Buffer
-	stdout, _, err := g.runAndLogOutput(ctx, pipe.Shell("git rev-parse --abbrev-ref HEAD"))
+	stdout, _, err := g.runAndLogOutput(ctx, g.git("rev-parse", "--abbrev-ref", "HEAD"))
 	if err != nil {
 		return "", fmt.Errorf("failed to get current branch name: %w", err)
 	}
@@ -214,19 +575,115 @@ func (g *GitCli) CurrentBranchName(ctx context.Context) (string, error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
-func (g *GitCli) ForcePushHead(ctx context.Context, repository string, ref string) error {
-	var stdout, stderr bytes.Buffer
-	if err := pipe.NewPiped("git", "push", "--force", repository, fmt.Sprintf("HEAD:%s", ref)).Execute(ctx, nil, &stdout, &stderr); err != nil {
+func (g *GitCli) PushHeadWithLease(ctx context.Context, repository string, ref string, expectedSha string) error {
+	lease := fmt.Sprintf("--force-with-lease=refs/heads/%s:%s", ref, expectedSha)
+	stdout, stderr, err := g.runRemote(ctx, "push", lease, repository, fmt.Sprintf("HEAD:refs/heads/%s", ref))
+	if err != nil {
+		if strings.Contains(stderr.String(), "stale info") {
+			return fmt.Errorf("unable to push to %s: %w", ref, ErrRemoteBranchChanged)
+		}
 		return fmt.Errorf("failed to force push head (%s %s): %w", stdout.String(), stderr.String(), err)
 	}
 	return nil
 }
 
+func (g *GitCli) PushHead(ctx context.Context, repository string, ref string) error {
+	stdout, stderr, err := g.runRemote(ctx, "push", repository, fmt.Sprintf("HEAD:refs/heads/%s", ref))
+	if err != nil {
+		if strings.Contains(stderr.String(), "[rejected]") {
+			return fmt.Errorf("unable to push to %s: %w", ref, ErrNonFastForward)
+		}
+		return fmt.Errorf("failed to push head (%s %s): %w", stdout.String(), stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) Rebase(ctx context.Context, onto string) error {
+	args := []string{"rebase", onto}
+	if g.Signing != nil {
+		args = append(g.Signing.configArgs(), "rebase", "--gpg-sign", onto)
+	}
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git(args...)); err != nil {
+		if _, abortStderr, abortErr := g.runAndLogOutput(ctx, g.git("rebase", "--abort")); abortErr != nil {
+			g.Logger.Warn("failed to abort rebase", zap.String("stderr", abortStderr.String()), zap.Error(abortErr))
+		}
+		return fmt.Errorf("failed to rebase onto %s (%s): %w", onto, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) RemoteBranchSha(ctx context.Context, branch string) (string, error) {
+	stdout, stderr, err := g.runRemote(ctx, "ls-remote", "origin", "refs/heads/"+branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to list remote branch %s (%s): %w", branch, stderr.String(), err)
+	}
+	sha, _, _ := strings.Cut(strings.TrimSpace(stdout.String()), "\t")
+	return sha, nil
+}
+
+// pushedRef is where RecordPushedSha stores the SHA last pushed to a branch.  It is shared by every worktree.
+func pushedRef(branch string) string {
+	return "refs/releaser/pushed/" + branch
+}
+
+func (g *GitCli) LastPushedSha(ctx context.Context, branch string) (string, error) {
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("rev-parse", "--quiet", "--verify", pushedRef(branch)))
+	if err != nil {
+		if stderr.Len() == 0 {
+			// --quiet exits without output when the ref does not exist
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read last pushed sha of %s (%s): %w", branch, stderr.String(), err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func (g *GitCli) RecordPushedSha(ctx context.Context, branch string, sha string) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("update-ref", pushedRef(branch), sha)); err != nil {
+		return fmt.Errorf("failed to record pushed sha of %s (%s): %w", branch, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) ChangedFiles(ctx context.Context, from string, to string, paths ...string) ([]string, error) {
+	args := []string{"diff", "--name-only", "--no-renames", "-z", from}
+	if to == "" {
+		// Untracked files only show up in the diff once git knows they will be added
+		if _, stderr, err := g.runAndLogOutput(ctx, g.git(append([]string{"add", "--all", "--intent-to-add", "--"}, paths...)...)); err != nil {
+			return nil, fmt.Errorf("git add failed (%s): %w", stderr.String(), err)
+		}
+	} else {
+		args = append(args, to)
+	}
+	args = append(append(args, "--"), paths...)
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git(args...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s and %s (%s): %w", from, to, stderr.String(), err)
+	}
+	var ret []string
+	for _, name := range strings.Split(stdout.String(), "\x00") {
+		if name != "" {
+			ret = append(ret, name)
+		}
+	}
+	return ret, nil
+}
+
+func (g *GitCli) DeleteRemoteBranch(ctx context.Context, branch string) error {
+	if _, stderr, err := g.runRemote(ctx, "push", "origin", "--delete", "refs/heads/"+branch); err != nil {
+		return fmt.Errorf("failed to delete remote branch %s (%s): %w", branch, stderr.String(), err)
+	}
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("update-ref", "-d", pushedRef(branch))); err != nil {
+		return fmt.Errorf("failed to forget pushed sha of %s (%s): %w", branch, stderr.String(), err)
+	}
+	return nil
+}
+
 func (g *GitCli) AreThereUncommittedChanges(ctx context.Context) (bool, error) {
 	g.Logger.Debug("AreThereUncommittedChanges")
 	defer g.Logger.Debug("AreThereUncommittedChanges done")
 	var stdout, stderr bytes.Buffer
-	err := pipe.Shell("git status --short").Execute(ctx, nil, &stdout, &stderr)
+	err := g.git("status", "--short").Execute(ctx, nil, &stdout, &stderr)
 	g.Logger.Debug("ran git status", zap.String("stdout", stdout.String()), zap.String("stderr", stderr.String()))
 	if err != nil {
 		return false, fmt.Errorf("git status failed: %w", err)
@@ -239,18 +696,126 @@ func (g *GitCli) AreThereUncommittedChanges(ctx context.Context) (bool, error) {
 
 func (g *GitCli) CommitAll(ctx context.Context, message string) error {
 	var stdout, stderr bytes.Buffer
-	if err := pipe.Shell("git add .").Execute(ctx, nil, &stdout, &stderr); err != nil {
+	if err := g.git("add", ".").Execute(ctx, nil, &stdout, &stderr); err != nil {
 		return fmt.Errorf("git add failed (%s %s): %w", stdout.String(), stderr.String(), err)
 	}
-	if err := pipe.NewPiped("git", "commit", "-a", "-m", message).Execute(ctx, nil, &stdout, &stderr); err != nil {
+	args := []string{"commit", "-a", "-m", message}
+	if g.Signing != nil {
+		args = append(g.Signing.configArgs(), "commit", "--gpg-sign", "-a", "-m", message)
+	}
+	if err := g.git(args...).Execute(ctx, nil, &stdout, &stderr); err != nil {
 		return fmt.Errorf("git commit failed (%s %s): %w", stdout.String(), stderr.String(), err)
 	}
 	return nil
 }
 
+func (g *GitCli) StagedChanges(ctx context.Context) (*FileChanges, error) {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("add", "--all")); err != nil {
+		return nil, fmt.Errorf("git add failed (%s): %w", stderr.String(), err)
+	}
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("diff", "--cached", "--raw", "--no-renames", "-z", "HEAD"))
+	if err != nil {
+		return nil, fmt.Errorf("git diff failed (%s): %w", stderr.String(), err)
+	}
+	// With -z, each change is ":<old mode> <new mode> <old sha> <new sha> <status>" followed by the path, each NUL
+	// terminated
+	fields := strings.Split(strings.TrimSuffix(stdout.String(), "\x00"), "\x00")
+	var ret FileChanges
+	for i := 0; i+1 < len(fields); i += 2 {
+		info := strings.Fields(strings.TrimPrefix(fields[i], ":"))
+		if len(info) != 5 {
+			return nil, fmt.Errorf("unable to parse git diff output %s", fields[i])
+		}
+		path := fields[i+1]
+		if info[4] == "D" {
+			ret.Deletions = append(ret.Deletions, path)
+			continue
+		}
+		var contents, catStderr bytes.Buffer
+		if err := g.git("cat-file", "blob", info[3]).Execute(ctx, nil, &contents, &catStderr); err != nil {
+			return nil, fmt.Errorf("unable to read staged content of %s (%s): %w", path, catStderr.String(), err)
+		}
+		ret.Additions = append(ret.Additions, FileAddition{
+			Path:     path,
+			Contents: contents.Bytes(),
+			Mode:     info[1],
+		})
+	}
+	return &ret, nil
+}
+
+func (g *GitCli) FetchBranch(ctx context.Context, branch string) error {
+	refspec := fmt.Sprintf("+refs/heads/%s:refs/remotes/origin/%s", branch, branch)
+	if _, stderr, err := g.runRemote(ctx, "fetch", "origin", refspec); err != nil {
+		return fmt.Errorf("failed to fetch branch %s (%s): %w", branch, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) ResetHard(ctx context.Context, ref string) error {
+	if _, stderr, err := g.runAndLogOutput(ctx, g.git("reset", "--hard", ref)); err != nil {
+		return fmt.Errorf("failed to reset to %s (%s): %w", ref, stderr.String(), err)
+	}
+	return nil
+}
+
+func (g *GitCli) AuthorIdentity(ctx context.Context) (string, error) {
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git("var", "GIT_AUTHOR_IDENT"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get git author (%s): %w", stderr.String(), err)
+	}
+	// The identity is followed by a timestamp: "Name <email> 1650000000 +0000"
+	ident := strings.TrimSpace(stdout.String())
+	if idx := strings.LastIndex(ident, ">"); idx != -1 {
+		ident = ident[:idx+1]
+	}
+	return ident, nil
+}
+
+func (g *GitCli) Log(ctx context.Context, ref string, limit int, grep string) ([]Commit, error) {
+	// Fields are separated by the unit separator, and commits by NUL (-z)
+	args := []string{"log", "-z", "--format=%H%x1f%cI%x1f%B"}
+	if limit > 0 {
+		args = append(args, fmt.Sprintf("--max-count=%d", limit))
+	}
+	if grep != "" {
+		args = append(args, "--extended-regexp", "--grep="+grep)
+	}
+	args = append(args, ref, "--")
+	stdout, stderr, err := g.runAndLogOutput(ctx, g.git(args...))
+	if err != nil {
+		return nil, fmt.Errorf("git log failed (%s): %w", stderr.String(), err)
+	}
+	var ret []Commit
+	for _, entry := range strings.Split(stdout.String(), "\x00") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "\x1f", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("unable to parse git log entry %s", entry)
+		}
+		t, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse commit time %s: %w", parts[1], err)
+		}
+		ret = append(ret, Commit{
+			Sha:     strings.TrimSpace(parts[0]),
+			Time:    t,
+			Message: parts[2],
+		})
+	}
+	return ret, nil
+}
+
 func (g *GitCli) CheckoutNewBranch(ctx context.Context, branch string) error {
+	defaultBranch, err := g.DefaultBranch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get default branch: %w", err)
+	}
 	var stdout, stderr bytes.Buffer
-	err := pipe.NewPiped("git", "checkout", "-b", branch, "origin/master").Execute(ctx, nil, &stdout, &stderr)
+	// -B resets a branch left behind by a previous promotion, so the branch always starts from the default branch
+	err = g.git("checkout", "-B", branch, "origin/"+defaultBranch).Execute(ctx, nil, &stdout, &stderr)
 	if err != nil {
 		return fmt.Errorf("git checkout failed (%s:%s): %w", stdout.String(), stderr.String(), err)
 	}
@@ -1,40 +1,98 @@
 package releaser
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/bradleyfalzon/ghinstallation"
 	"github.com/shurcooL/githubv4"
-	"github.com/shurcooL/graphql"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"gopkg.in/yaml.v2"
 )
 
+// GitHub is a CodeHost with features only GitHub has
 type GitHub interface {
-	// CreatePullRequest creates a PR of your current branch.  It assumes there is a remote branch with the
-	// exact same name.  It will fail if you're already on master or main.
-	CreatePullRequest(ctx context.Context, remoteRepositoryId graphql.ID, baseRefName string, remoteRefName string, title string, body string) (int64, error)
+	CodeHost
 	// RepositoryInfo returns special information about a remote repository
 	RepositoryInfo(ctx context.Context, owner string, name string) (*RepositoryInfo, error)
-	// FindPRForBranch returns the PR for this branch
-	FindPRForBranch(ctx context.Context, owner string, name string, branch string) (int64, error)
-	// Self returns the current user
-	Self(ctx context.Context) (string, error)
-	// AcceptPullRequest approves a PR
-	AcceptPullRequest(ctx context.Context, approvalmessage string, owner string, name string, number int64) error
-	// MergePullRequest merges in a PR and closes it, but only if it's approved
-	MergePullRequest(ctx context.Context, owner string, name string, number int64) error
 	// FindPullRequestOid returns the OID of the PR
 	FindPullRequestOid(ctx context.Context, owner string, name string, number int64) (githubv4.ID, error)
-	GetAccessToken(ctx context.Context) (string, error)
+	// CreateCommitOnBranch commits changes on top of the remote branch, which must currently point at
+	// expectedHeadOid.  GitHub signs the commit as the authenticated user or app.  Returns the new commit's SHA.
+	CreateCommitOnBranch(ctx context.Context, owner string, name string, branch string, expectedHeadOid string, message string, changes *FileChanges) (string, error)
+	// EnablePullRequestAutoMerge makes GitHub merge the PR with method as soon as its requirements, such as reviews
+	// and status checks, are met
+	EnablePullRequestAutoMerge(ctx context.Context, owner string, name string, number int64, method MergeMethod) error
+	// MergedPullRequest returns whether and how a PR was merged
+	MergedPullRequest(ctx context.Context, owner string, name string, number int64) (*MergedPullRequest, error)
+	// CreateDeployment creates a deployment of a commit to an environment
+	CreateDeployment(ctx context.Context, owner string, name string, request *DeploymentRequest) (*Deployment, error)
+	// CreateDeploymentStatus adds a status to a deployment
+	CreateDeploymentStatus(ctx context.Context, owner string, name string, deploymentID int64, state DeploymentState, description string) error
+	// ListDeployments returns the most recent deployments to an environment, newest first, with their latest state
+	ListDeployments(ctx context.Context, owner string, name string, environment string) ([]Deployment, error)
+	// UserPermission returns the permission a user has on a repository, or an empty permission if they are not a
+	// collaborator
+	UserPermission(ctx context.Context, owner string, name string, login string) (RepositoryPermission, error)
+	// CommentOnPullRequest adds a comment to a PR
+	CommentOnPullRequest(ctx context.Context, owner string, name string, number int64, body string) error
+	// SetPullRequestHold converts a PR to a draft, so it cannot be merged, or marks it ready for review again
+	SetPullRequestHold(ctx context.Context, owner string, name string, number int64, hold bool) error
+}
+
+// RepositoryPermission is the access level a user has on a repository
+type RepositoryPermission string
+
+const (
+	RepositoryPermissionAdmin    RepositoryPermission = "ADMIN"
+	RepositoryPermissionMaintain RepositoryPermission = "MAINTAIN"
+	RepositoryPermissionWrite    RepositoryPermission = "WRITE"
+	RepositoryPermissionTriage   RepositoryPermission = "TRIAGE"
+	RepositoryPermissionRead     RepositoryPermission = "READ"
+)
+
+// CanWrite returns true if the permission allows pushing to the repository
+func (p RepositoryPermission) CanWrite() bool {
+	switch p {
+	case RepositoryPermissionAdmin, RepositoryPermissionMaintain, RepositoryPermissionWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// MergeMethod is how a PR is merged into its base branch
+type MergeMethod string
+
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
+func (m MergeMethod) githubv4() (githubv4.PullRequestMergeMethod, error) {
+	switch m {
+	case MergeMethodMerge:
+		return githubv4.PullRequestMergeMethodMerge, nil
+	case MergeMethodSquash:
+		return githubv4.PullRequestMergeMethodSquash, nil
+	case MergeMethodRebase:
+		return githubv4.PullRequestMergeMethodRebase, nil
+	default:
+		return "", fmt.Errorf("unknown merge method %s", m)
+	}
 }
 
 type RepositoryInfo struct {
@@ -58,10 +116,13 @@ type createPullRequest struct {
 }
 
 type GithubGraphqlAPI struct {
-	ClientV4      *githubv4.Client
-	Logger        *zap.Logger
-	tokenFunction func(ctx context.Context) (string, error)
-	findPrCache   ExpireCache[findPrKey, findPrValue]
+	ClientV4    *githubv4.Client
+	Logger      *zap.Logger
+	tokens      *TokenCache
+	findPrCache ExpireCache[findPrKey, findPrValue]
+	// restClient and restURL reach the REST API, for the few features the GraphQL API lacks
+	restClient *http.Client
+	restURL    string
 }
 
 type findPrKey struct {
@@ -75,7 +136,11 @@ type findPrValue struct {
 }
 
 func (g *GithubGraphqlAPI) GetAccessToken(ctx context.Context) (string, error) {
-	return g.tokenFunction(ctx)
+	return g.tokens.Token(ctx)
+}
+
+func (g *GithubGraphqlAPI) AccessTokens() *TokenCache {
+	return g.tokens
 }
 
 func (g *GithubGraphqlAPI) FindPullRequestOid(ctx context.Context, owner string, name string, number int64) (githubv4.ID, error) {
@@ -103,6 +168,10 @@ func (g *GithubGraphqlAPI) FindPullRequestOid(ctx context.Context, owner string,
 	return query.Repository.PullRequest.ID, nil
 }
 
+func (g *GithubGraphqlAPI) ForgetPullRequests() {
+	g.findPrCache.Clear()
+}
+
 func (g *GithubGraphqlAPI) AcceptPullRequest(ctx context.Context, approvalmessage string, owner string, name string, number int64) error {
 	defer g.findPrCache.Clear()
 	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
@@ -155,6 +224,344 @@ func (g *GithubGraphqlAPI) MergePullRequest(ctx context.Context, owner string, n
 	return nil
 }
 
+func (g *GithubGraphqlAPI) EnablePullRequestAutoMerge(ctx context.Context, owner string, name string, number int64, method MergeMethod) error {
+	mergeMethod, err := method.githubv4()
+	if err != nil {
+		return err
+	}
+	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
+	if err != nil {
+		return fmt.Errorf("failed to find PR: %w", err)
+	}
+	g.Logger.Debug("EnablePullRequestAutoMerge", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number), zap.String("method", string(method)))
+	defer g.Logger.Debug("Done EnablePullRequestAutoMerge")
+	var ret struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.EnablePullRequestAutoMergeInput{
+		PullRequestID: prid,
+		MergeMethod:   &mergeMethod,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to enable auto-merge: %w", err)
+	}
+	return nil
+}
+
+func (g *GithubGraphqlAPI) CreateCommitOnBranch(ctx context.Context, owner string, name string, branch string, expectedHeadOid string, message string, changes *FileChanges) (string, error) {
+	g.Logger.Debug("CreateCommitOnBranch", zap.String("owner", owner), zap.String("name", name), zap.String("branch", branch), zap.String("expectedHeadOid", expectedHeadOid))
+	defer g.Logger.Debug("Done CreateCommitOnBranch")
+	additions := make([]githubv4.FileAddition, 0, len(changes.Additions))
+	for _, a := range changes.Additions {
+		additions = append(additions, githubv4.FileAddition{
+			Path:     githubv4.String(a.Path),
+			Contents: githubv4.Base64String(base64.StdEncoding.EncodeToString(a.Contents)),
+		})
+	}
+	deletions := make([]githubv4.FileDeletion, 0, len(changes.Deletions))
+	for _, d := range changes.Deletions {
+		deletions = append(deletions, githubv4.FileDeletion{
+			Path: githubv4.String(d),
+		})
+	}
+	headline, body, _ := strings.Cut(message, "\n")
+	commitMessage := githubv4.CommitMessage{
+		Headline: githubv4.String(headline),
+	}
+	if body = strings.TrimSpace(body); body != "" {
+		commitMessage.Body = githubv4.NewString(githubv4.String(body))
+	}
+	var ret struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				Oid githubv4.GitObjectID
+			}
+		} `graphql:"createCommitOnBranch(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.CreateCommitOnBranchInput{
+		Branch: githubv4.CommittableBranch{
+			RepositoryNameWithOwner: githubv4.NewString(githubv4.String(owner + "/" + name)),
+			BranchName:              githubv4.NewString(githubv4.String(branch)),
+		},
+		Message:         commitMessage,
+		ExpectedHeadOid: githubv4.GitObjectID(expectedHeadOid),
+		FileChanges: &githubv4.FileChanges{
+			Additions: &additions,
+			Deletions: &deletions,
+		},
+	}, nil); err != nil {
+		return "", fmt.Errorf("unable to create commit on branch %s: %w", branch, err)
+	}
+	return string(ret.CreateCommitOnBranch.Commit.Oid), nil
+}
+
+// githubPullRequest is the part of a pull request that PullRequestDetails is built from
+type githubPullRequest struct {
+	Number      githubv4.Int
+	HeadRefName githubv4.String
+	State       githubv4.String
+	IsDraft     githubv4.Boolean
+	Author      struct {
+		Login githubv4.String
+	}
+	ReviewDecision           githubv4.String
+	Mergeable                githubv4.String
+	LatestOpinionatedReviews struct {
+		Nodes []struct {
+			State githubv4.String
+		}
+	} `graphql:"latestOpinionatedReviews(first: 100)"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				Message           githubv4.String
+				StatusCheckRollup *struct {
+					State githubv4.String
+				}
+			}
+		}
+	} `graphql:"commits(last: 1)"`
+}
+
+func (pr *githubPullRequest) details() *PullRequestDetails {
+	ret := &PullRequestDetails{
+		Number:         int64(pr.Number),
+		State:          PullRequestState(strings.ToLower(string(pr.State))),
+		Author:         string(pr.Author.Login),
+		ReviewDecision: ReviewDecision(strings.ToLower(string(pr.ReviewDecision))),
+		Mergeable:      MergeableState(strings.ToLower(string(pr.Mergeable))),
+		Draft:          bool(pr.IsDraft),
+	}
+	for _, r := range pr.LatestOpinionatedReviews.Nodes {
+		if r.State == "APPROVED" {
+			ret.Approvals++
+		}
+	}
+	if len(pr.Commits.Nodes) > 0 {
+		ret.HeadMessage = string(pr.Commits.Nodes[0].Commit.Message)
+	}
+	if len(pr.Commits.Nodes) > 0 && pr.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+		switch pr.Commits.Nodes[0].Commit.StatusCheckRollup.State {
+		case "SUCCESS":
+			ret.Checks = CheckStateSuccess
+		case "FAILURE", "ERROR":
+			ret.Checks = CheckStateFailure
+		default:
+			ret.Checks = CheckStatePending
+		}
+	}
+	return ret
+}
+
+func (g *GithubGraphqlAPI) PullRequestDetails(ctx context.Context, owner string, name string, number int64) (*PullRequestDetails, error) {
+	g.Logger.Debug("PullRequestDetails", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number))
+	defer g.Logger.Debug("Done PullRequestDetails")
+	var query struct {
+		Repository struct {
+			PullRequest githubPullRequest `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"number": githubv4.Int(number),
+	}
+	if err := g.ClientV4.Query(ctx, &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to query for PR %d: %w", number, err)
+	}
+	if query.Repository.PullRequest.Number == 0 {
+		return nil, fmt.Errorf("failed to find PR %d", number)
+	}
+	return query.Repository.PullRequest.details(), nil
+}
+
+// ListPullRequests pages through every open PR, and includes their details so callers do not need a query per PR
+func (g *GithubGraphqlAPI) ListPullRequests(ctx context.Context, owner string, name string, headPrefix string) ([]PullRequestSummary, error) {
+	g.Logger.Debug("ListPullRequests", zap.String("owner", owner), zap.String("name", name), zap.String("headPrefix", headPrefix))
+	defer g.Logger.Debug("Done ListPullRequests")
+	var query struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes    []githubPullRequest
+				PageInfo struct {
+					EndCursor   githubv4.String
+					HasNextPage githubv4.Boolean
+				}
+			} `graphql:"pullRequests(states: [OPEN], first: 100, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"cursor": (*githubv4.String)(nil),
+	}
+	var ret []PullRequestSummary
+	for {
+		if err := g.ClientV4.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to query for PRs: %w", err)
+		}
+		for i := range query.Repository.PullRequests.Nodes {
+			pr := &query.Repository.PullRequests.Nodes[i]
+			if strings.HasPrefix(string(pr.HeadRefName), headPrefix) {
+				ret = append(ret, PullRequestSummary{
+					Number:      int64(pr.Number),
+					HeadRefName: string(pr.HeadRefName),
+					Details:     pr.details(),
+				})
+			}
+		}
+		if !query.Repository.PullRequests.PageInfo.HasNextPage {
+			return ret, nil
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.PullRequests.PageInfo.EndCursor)
+	}
+}
+
+func (g *GithubGraphqlAPI) UpdatePullRequestBody(ctx context.Context, owner string, name string, number int64, body string) error {
+	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
+	if err != nil {
+		return fmt.Errorf("failed to find PR: %w", err)
+	}
+	g.Logger.Debug("UpdatePullRequestBody", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number))
+	defer g.Logger.Debug("Done UpdatePullRequestBody")
+	var ret struct {
+		UpdatePullRequest struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updatePullRequest(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.UpdatePullRequestInput{
+		PullRequestID: prid,
+		Body:          githubv4.NewString(githubv4.String(body)),
+	}, nil); err != nil {
+		return fmt.Errorf("unable to update PR: %w", err)
+	}
+	return nil
+}
+
+func (g *GithubGraphqlAPI) ClosePullRequest(ctx context.Context, owner string, name string, number int64, comment string) error {
+	defer g.findPrCache.Clear()
+	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
+	if err != nil {
+		return fmt.Errorf("failed to find PR: %w", err)
+	}
+	g.Logger.Debug("ClosePullRequest", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number))
+	defer g.Logger.Debug("Done ClosePullRequest")
+	if comment != "" {
+		if err := g.addComment(ctx, prid, comment); err != nil {
+			return err
+		}
+	}
+	var ret struct {
+		ClosePullRequest struct {
+			PullRequest struct {
+				ID githubv4.ID
+			}
+		} `graphql:"closePullRequest(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.ClosePullRequestInput{
+		PullRequestID: prid,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to close PR: %w", err)
+	}
+	return nil
+}
+
+func (g *GithubGraphqlAPI) CommentOnPullRequest(ctx context.Context, owner string, name string, number int64, body string) error {
+	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
+	if err != nil {
+		return fmt.Errorf("failed to find PR: %w", err)
+	}
+	g.Logger.Debug("CommentOnPullRequest", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number))
+	defer g.Logger.Debug("Done CommentOnPullRequest")
+	return g.addComment(ctx, prid, body)
+}
+
+func (g *GithubGraphqlAPI) addComment(ctx context.Context, prid githubv4.ID, body string) error {
+	var ret struct {
+		AddComment struct {
+			ClientMutationID githubv4.ID
+		} `graphql:"addComment(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.AddCommentInput{
+		SubjectID: prid,
+		Body:      githubv4.String(body),
+	}, nil); err != nil {
+		return fmt.Errorf("unable to comment on PR: %w", err)
+	}
+	return nil
+}
+
+func (g *GithubGraphqlAPI) SetPullRequestHold(ctx context.Context, owner string, name string, number int64, hold bool) error {
+	defer g.findPrCache.Clear()
+	prid, err := g.FindPullRequestOid(ctx, owner, name, number)
+	if err != nil {
+		return fmt.Errorf("failed to find PR: %w", err)
+	}
+	g.Logger.Debug("SetPullRequestHold", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number), zap.Bool("hold", hold))
+	defer g.Logger.Debug("Done SetPullRequestHold")
+	if hold {
+		var ret struct {
+			ConvertPullRequestToDraft struct {
+				ClientMutationID githubv4.ID
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+		if err := g.ClientV4.Mutate(ctx, &ret, githubv4.ConvertPullRequestToDraftInput{
+			PullRequestID: prid,
+		}, nil); err != nil {
+			return fmt.Errorf("unable to convert PR to draft: %w", err)
+		}
+		return nil
+	}
+	var ret struct {
+		MarkPullRequestReadyForReview struct {
+			ClientMutationID githubv4.ID
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.MarkPullRequestReadyForReviewInput{
+		PullRequestID: prid,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to mark PR ready for review: %w", err)
+	}
+	return nil
+}
+
+func (g *GithubGraphqlAPI) UserPermission(ctx context.Context, owner string, name string, login string) (RepositoryPermission, error) {
+	g.Logger.Debug("UserPermission", zap.String("owner", owner), zap.String("name", name), zap.String("login", login))
+	defer g.Logger.Debug("Done UserPermission")
+	var query struct {
+		Repository struct {
+			Collaborators struct {
+				Edges []struct {
+					Permission githubv4.RepositoryPermission
+					Node       struct {
+						Login githubv4.String
+					}
+				}
+			} `graphql:"collaborators(query: $login, first: 10)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+		"login": githubv4.String(login),
+	}
+	if err := g.ClientV4.Query(ctx, &query, variables); err != nil {
+		return "", fmt.Errorf("failed to query permission of %s: %w", login, err)
+	}
+	// The query also matches users whose login or name merely contains login
+	for _, e := range query.Repository.Collaborators.Edges {
+		if strings.EqualFold(string(e.Node.Login), login) {
+			return RepositoryPermission(e.Permission), nil
+		}
+	}
+	return "", nil
+}
+
 type GraphQLPRQueryNode struct {
 	Number githubv4.Int
 }
@@ -208,6 +615,11 @@ type NewGQLClientConfig struct {
 	InstallationID int64
 	PEMKeyLoc      string
 	Token          string
+	// BaseURL is the root of a GitHub Enterprise Server instance, such as https://github.example.com.  Defaults to
+	// https:// plus Host when Host is not github.com.
+	BaseURL string
+	// Host is the host of the repository's remote.  It picks the token from the `gh` CLI config.
+	Host string
 }
 
 var DefaultGQLClientConfig = NewGQLClientConfig{
@@ -216,6 +628,29 @@ var DefaultGQLClientConfig = NewGQLClientConfig{
 	InstallationID: intFromOsEnv("GITHUB_INSTALLATION_ID"),
 	PEMKeyLoc:      os.Getenv("GITHUB_PEM_KEY_LOC"),
 	Token:          os.Getenv("GITHUB_TOKEN"),
+	BaseURL:        os.Getenv("GITHUB_URL"),
+}
+
+const githubDotCom = "github.com"
+
+// githubAPIURLs returns the GraphQL endpoint and REST API root of a GitHub instance
+func githubAPIURLs(baseURL string) (graphqlURL string, restURL string) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if u, err := url.Parse(baseURL); baseURL == "" || (err == nil && strings.EqualFold(u.Hostname(), githubDotCom)) {
+		return "https://api.github.com/graphql", "https://api.github.com"
+	}
+	return baseURL + "/api/graphql", baseURL + "/api/v3"
+}
+
+// host is the GitHub host the config talks to, which is how the `gh` CLI keys its tokens
+func (c *NewGQLClientConfig) host() string {
+	if c.Host != "" {
+		return c.Host
+	}
+	if u, err := url.Parse(c.BaseURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return githubDotCom
 }
 
 func intFromOsEnv(s string) int64 {
@@ -230,46 +665,58 @@ func intFromOsEnv(s string) int64 {
 	return i
 }
 
-func createGraphqlAPI(gql *githubv4.Client, logger *zap.Logger, tokenFunction func(context.Context) (string, error)) *GithubGraphqlAPI {
+func createGraphqlAPI(gql *githubv4.Client, logger *zap.Logger, tokens *TokenCache) *GithubGraphqlAPI {
 	return &GithubGraphqlAPI{
-		ClientV4:      gql,
-		Logger:        logger,
-		tokenFunction: tokenFunction,
+		ClientV4: gql,
+		Logger:   logger,
+		tokens:   tokens,
 		findPrCache: ExpireCache[findPrKey, findPrValue]{
 			defaultExpiry: time.Minute,
 		},
 	}
 }
 
-func clientFromToken(_ context.Context, logger *zap.Logger, token string) (GitHub, error) {
+func clientFromToken(_ context.Context, logger *zap.Logger, baseURL string, token string) (GitHub, error) {
 	src := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
 	httpClient := oauth2.NewClient(context.Background(), src)
-	httpClient.Transport = DebugLogTransport(httpClient.Transport, logger)
-	gql := githubv4.NewClient(httpClient)
-	return createGraphqlAPI(gql, logger, func(_ context.Context) (string, error) {
-		return token, nil
-	}), nil
+	httpClient.Transport = NewRateLimitTransport(DebugLogTransport(httpClient.Transport, logger), logger)
+	graphqlURL, restURL := githubAPIURLs(baseURL)
+	gql := githubv4.NewEnterpriseClient(graphqlURL, httpClient)
+	ret := createGraphqlAPI(gql, logger, StaticTokenCache(token))
+	ret.restClient = httpClient
+	ret.restURL = restURL
+	return ret, nil
 }
 
-func clientFromPEM(ctx context.Context, logger *zap.Logger, baseRoundTripper http.RoundTripper, appID int64, installID int64, pemLoc string) (GitHub, error) {
+func clientFromPEM(ctx context.Context, logger *zap.Logger, baseRoundTripper http.RoundTripper, baseURL string, appID int64, installID int64, pemLoc string) (GitHub, error) {
 	if baseRoundTripper == nil {
 		baseRoundTripper = http.DefaultTransport
 	}
-	trans, err := ghinstallation.NewKeyFromFile(baseRoundTripper, appID, installID, pemLoc)
+	graphqlURL, restURL := githubAPIURLs(baseURL)
+	// Installation tokens come from the REST API of the same instance
+	fetch, err := installationTokenFetcher(baseRoundTripper, restURL, appID, installID, pemLoc)
 	if err != nil {
-		return nil, fmt.Errorf("unable to find key file: %w", err)
+		return nil, err
 	}
-	_, err = trans.Token(ctx)
-	if err != nil {
+	tokens := NewTokenCache(logger, fetch)
+	if _, err := tokens.Token(ctx); err != nil {
 		return nil, fmt.Errorf("unable to validate token: %w", err)
 	}
-	gql := githubv4.NewClient(&http.Client{Transport: DebugLogTransport(trans, logger)})
-	return createGraphqlAPI(gql, logger, trans.Token), nil
+	httpClient := &http.Client{Transport: NewRateLimitTransport(&tokenTransport{
+		Base:   DebugLogTransport(baseRoundTripper, logger),
+		Tokens: tokens,
+	}, logger)}
+	gql := githubv4.NewEnterpriseClient(graphqlURL, httpClient)
+	ret := createGraphqlAPI(gql, logger, tokens)
+	ret.restClient = httpClient
+	ret.restURL = restURL
+	return ret, nil
 }
 
-func tokenFromGithubCLI() string {
+// tokenFromGithubCLI returns the token the `gh` CLI is logged in to host with, if any
+func tokenFromGithubCLI(host string) string {
 	s, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -283,12 +730,16 @@ func tokenFromGithubCLI() string {
 	if err := yaml.Unmarshal(b, &out); err != nil {
 		return ""
 	}
-	return tokenForAny(out, "github.com", "Github.com")
+	return tokenForHost(out, host)
 }
 
-func tokenForAny(m map[string]configFileAuths, hosts ...string) string {
-	for _, host := range hosts {
-		if auth, exists := m[host]; exists {
+func tokenForHost(m map[string]configFileAuths, host string) string {
+	if auth, exists := m[host]; exists {
+		return auth.Token
+	}
+	// Host names are case insensitive, but map keys are not
+	for h, auth := range m {
+		if strings.EqualFold(h, host) {
 			return auth.Token
 		}
 	}
@@ -301,16 +752,20 @@ type configFileAuths struct {
 
 func NewGQLClient(ctx context.Context, logger *zap.Logger, cfg *NewGQLClientConfig) (GitHub, error) {
 	cfg = mergeGithubConfigs(cfg, &DefaultGQLClientConfig)
-	if cfg != nil && cfg.Token != "" {
-		return clientFromToken(ctx, logger, cfg.Token)
+	baseURL := cfg.BaseURL
+	if baseURL == "" && cfg.Host != "" && !strings.EqualFold(cfg.Host, githubDotCom) {
+		baseURL = "https://" + cfg.Host
 	}
-	if cfg != nil && cfg.PEMKeyLoc != "" {
-		return clientFromPEM(ctx, logger, cfg.Rt, cfg.AppID, cfg.InstallationID, cfg.PEMKeyLoc)
+	if cfg.Token != "" {
+		return clientFromToken(ctx, logger, baseURL, cfg.Token)
 	}
-	if token := tokenFromGithubCLI(); token != "" {
-		return clientFromToken(ctx, logger, token)
+	if cfg.PEMKeyLoc != "" {
+		return clientFromPEM(ctx, logger, cfg.Rt, baseURL, cfg.AppID, cfg.InstallationID, cfg.PEMKeyLoc)
 	}
-	return nil, fmt.Errorf("no token provided: I need either GITHUB_TOKEN env, existing auth via the `gh` CLI, or a PEM key")
+	if token := tokenFromGithubCLI(cfg.host()); token != "" {
+		return clientFromToken(ctx, logger, baseURL, token)
+	}
+	return nil, fmt.Errorf("no token provided for %s: I need either GITHUB_TOKEN env, existing auth via the `gh` CLI, or a PEM key", cfg.host())
 }
 
 func mergeGithubConfigs(cfg *NewGQLClientConfig, config *NewGQLClientConfig) *NewGQLClientConfig {
@@ -333,6 +788,12 @@ func mergeGithubConfigs(cfg *NewGQLClientConfig, config *NewGQLClientConfig) *Ne
 	if ret.Token == "" {
 		ret.Token = config.Token
 	}
+	if ret.BaseURL == "" {
+		ret.BaseURL = config.BaseURL
+	}
+	if ret.Host == "" {
+		ret.Host = config.Host
+	}
 	return &ret
 }
 
@@ -346,20 +807,27 @@ func (g *GithubGraphqlAPI) Self(ctx context.Context) (string, error) {
 		}
 	}
 	if err := g.ClientV4.Query(ctx, &q, nil); err != nil {
+		if strings.Contains(err.Error(), "Resource not accessible by integration") {
+			return "", fmt.Errorf("unable to run graphql query self: %s: %w", err, ErrSelfUnavailable)
+		}
 		return "", fmt.Errorf("unable to run graphql query self: %w", err)
 	}
 	return string(q.Viewer.Login), nil
 }
 
-func (g *GithubGraphqlAPI) CreatePullRequest(ctx context.Context, remoteRepositoryId graphql.ID, baseRefName string, remoteRefName string, title string, body string) (int64, error) {
+func (g *GithubGraphqlAPI) CreatePullRequest(ctx context.Context, owner string, name string, baseRefName string, headRefName string, title string, body string) (int64, error) {
 	defer g.findPrCache.Clear()
-	g.Logger.Debug("creating pull request", zap.Any("remoteRepositoryId", remoteRepositoryId), zap.String("baseRefName", baseRefName), zap.String("remoteRefName", remoteRefName), zap.String("title", title), zap.String("body", body))
+	info, err := g.RepositoryInfo(ctx, owner, name)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get repository info for %s/%s: %w", owner, name, err)
+	}
+	g.Logger.Debug("creating pull request", zap.Any("remoteRepositoryId", info.Repository.ID), zap.String("baseRefName", baseRefName), zap.String("headRefName", headRefName), zap.String("title", title), zap.String("body", body))
 	defer g.Logger.Debug("done creating pull request")
 	var ret createPullRequest
 	if err := g.ClientV4.Mutate(ctx, &ret, githubv4.CreatePullRequestInput{
-		RepositoryID: remoteRepositoryId,
+		RepositoryID: info.Repository.ID,
 		BaseRefName:  githubv4.String(baseRefName),
-		HeadRefName:  githubv4.String(remoteRefName),
+		HeadRefName:  githubv4.String(headRefName),
 		Title:        githubv4.String(title),
 		Body:         githubv4.NewString(githubv4.String(body)),
 	}, nil); err != nil {
@@ -381,4 +849,200 @@ func (g *GithubGraphqlAPI) RepositoryInfo(ctx context.Context, owner string, nam
 	return &repoInfo, nil
 }
 
+func (g *GithubGraphqlAPI) DefaultBranch(ctx context.Context, owner string, name string) (string, error) {
+	info, err := g.RepositoryInfo(ctx, owner, name)
+	if err != nil {
+		return "", err
+	}
+	if info.Repository.DefaultBranchRef.Name == "" {
+		return "", fmt.Errorf("repository %s/%s has no default branch", owner, name)
+	}
+	return string(info.Repository.DefaultBranchRef.Name), nil
+}
+
+func (g *GithubGraphqlAPI) MergedPullRequest(ctx context.Context, owner string, name string, number int64) (*MergedPullRequest, error) {
+	g.Logger.Debug("MergedPullRequest", zap.String("owner", owner), zap.String("name", name), zap.Int64("number", number))
+	defer g.Logger.Debug("Done MergedPullRequest")
+	var query struct {
+		Repository struct {
+			PullRequest struct {
+				Number      githubv4.Int
+				Merged      githubv4.Boolean
+				HeadRefName githubv4.String
+				MergeCommit *struct {
+					Oid githubv4.GitObjectID
+				}
+				Commits struct {
+					Nodes []struct {
+						Commit struct {
+							Message githubv4.String
+						}
+					}
+				} `graphql:"commits(last: 1)"`
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"number": githubv4.Int(number),
+	}
+	if err := g.ClientV4.Query(ctx, &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to query for PR %d: %w", number, err)
+	}
+	pr := query.Repository.PullRequest
+	if pr.Number == 0 {
+		return nil, fmt.Errorf("failed to find PR %d", number)
+	}
+	ret := &MergedPullRequest{
+		Number:      int64(pr.Number),
+		Merged:      bool(pr.Merged),
+		HeadRefName: string(pr.HeadRefName),
+	}
+	if pr.MergeCommit != nil {
+		ret.MergeCommitSha = string(pr.MergeCommit.Oid)
+	}
+	if len(pr.Commits.Nodes) > 0 {
+		ret.HeadMessage = string(pr.Commits.Nodes[0].Commit.Message)
+	}
+	return ret, nil
+}
+
+// restDeployment is a deployment in the REST API
+type restDeployment struct {
+	ID          int64           `json:"id"`
+	Sha         string          `json:"sha"`
+	Environment string          `json:"environment"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+func (d *restDeployment) deployment() *Deployment {
+	ret := &Deployment{
+		ID:          d.ID,
+		Sha:         d.Sha,
+		Environment: d.Environment,
+		CreatedAt:   d.CreatedAt,
+	}
+	// Deployments made by other tools can have any payload, including a plain string, so those are left empty
+	_ = json.Unmarshal(d.Payload, &ret.Payload)
+	return ret
+}
+
+func (g *GithubGraphqlAPI) CreateDeployment(ctx context.Context, owner string, name string, request *DeploymentRequest) (*Deployment, error) {
+	g.Logger.Debug("CreateDeployment", zap.String("owner", owner), zap.String("name", name), zap.String("ref", request.Ref), zap.String("environment", request.Environment))
+	defer g.Logger.Debug("Done CreateDeployment")
+	var ret restDeployment
+	if err := g.rest(ctx, http.MethodPost, repoPath(owner, name)+"/deployments", nil, map[string]interface{}{
+		"ref":         request.Ref,
+		"environment": request.Environment,
+		"description": request.Description,
+		"payload":     request.Payload,
+		"auto_merge":  false,
+		// The change is already merged, so its checks do not get a say
+		"required_contexts": []string{},
+	}, &ret); err != nil {
+		return nil, fmt.Errorf("unable to create deployment: %w", err)
+	}
+	return ret.deployment(), nil
+}
+
+func (g *GithubGraphqlAPI) CreateDeploymentStatus(ctx context.Context, owner string, name string, deploymentID int64, state DeploymentState, description string) error {
+	g.Logger.Debug("CreateDeploymentStatus", zap.String("owner", owner), zap.String("name", name), zap.Int64("deploymentID", deploymentID), zap.String("state", string(state)))
+	defer g.Logger.Debug("Done CreateDeploymentStatus")
+	if err := g.rest(ctx, http.MethodPost, fmt.Sprintf("%s/deployments/%d/statuses", repoPath(owner, name), deploymentID), nil, map[string]interface{}{
+		"state":       state,
+		"description": description,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to create status of deployment %d: %w", deploymentID, err)
+	}
+	return nil
+}
+
+// listDeploymentsLimit is how many deployments ListDeployments returns.  Each one costs a request for its latest status.
+const listDeploymentsLimit = 10
+
+func (g *GithubGraphqlAPI) ListDeployments(ctx context.Context, owner string, name string, environment string) ([]Deployment, error) {
+	g.Logger.Debug("ListDeployments", zap.String("owner", owner), zap.String("name", name), zap.String("environment", environment))
+	defer g.Logger.Debug("Done ListDeployments")
+	var deployments []restDeployment
+	if err := g.rest(ctx, http.MethodGet, repoPath(owner, name)+"/deployments", url.Values{
+		"environment": []string{environment},
+		"per_page":    []string{strconv.Itoa(listDeploymentsLimit)},
+	}, nil, &deployments); err != nil {
+		return nil, fmt.Errorf("unable to list deployments: %w", err)
+	}
+	ret := make([]Deployment, 0, len(deployments))
+	for _, d := range deployments {
+		deployment := d.deployment()
+		var statuses []struct {
+			State DeploymentState `json:"state"`
+		}
+		if err := g.rest(ctx, http.MethodGet, fmt.Sprintf("%s/deployments/%d/statuses", repoPath(owner, name), d.ID), url.Values{
+			"per_page": []string{"1"},
+		}, nil, &statuses); err != nil {
+			return nil, fmt.Errorf("unable to list statuses of deployment %d: %w", d.ID, err)
+		}
+		if len(statuses) > 0 {
+			deployment.State = statuses[0].State
+		}
+		ret = append(ret, *deployment)
+	}
+	return ret, nil
+}
+
+// repoPath is the URL path of a repository in the REST API
+func repoPath(owner string, name string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
+
+// rest sends a request to the REST API, decoding the JSON response into out if out is not nil
+func (g *GithubGraphqlAPI) rest(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	if g.restClient == nil {
+		return fmt.Errorf("no GitHub REST API client configured")
+	}
+	reqURL, err := url.Parse(g.restURL + path)
+	if err != nil {
+		return fmt.Errorf("unable to parse GitHub URL: %w", err)
+	}
+	reqURL.RawQuery = query.Encode()
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("unable to encode GitHub request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), body)
+	if err != nil {
+		return fmt.Errorf("unable to create GitHub request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.restClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub request %s %s failed: %w", method, path, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read GitHub response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("GitHub request %s %s failed with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("unable to decode GitHub response: %w", err)
+	}
+	return nil
+}
+
 var _ GitHub = &GithubGraphqlAPI{}
@@ -0,0 +1,387 @@
+package releaser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cresta/magehelper/pipe"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGithubPullRequestDetails(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), "latestOpinionatedReviews(first: 100)")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data": {"repository": {"pullRequest": {
+			"number": 123,
+			"state": "OPEN",
+			"reviewDecision": "APPROVED",
+			"mergeable": "MERGEABLE",
+			"latestOpinionatedReviews": {"nodes": [{"state": "APPROVED"}, {"state": "COMMENTED"}]},
+			"commits": {"nodes": [{"commit": {"statusCheckRollup": {"state": "ERROR"}}}]}
+		}}}}`)
+	}))
+	defer srv.Close()
+	gh := createGraphqlAPI(githubv4.NewEnterpriseClient(srv.URL, srv.Client()), zap.NewNop(), nil)
+	details, err := gh.PullRequestDetails(ctx, "cresta", "deploy", 123)
+	require.NoError(t, err)
+	require.Equal(t, &PullRequestDetails{
+		Number:         123,
+		State:          PullRequestStateOpen,
+		ReviewDecision: ReviewDecisionApproved,
+		Approvals:      1,
+		Mergeable:      MergeableStateMergeable,
+		Checks:         CheckStateFailure,
+	}, details)
+	require.Equal(t, "#123 open mergeable=mergeable approvals=1 review=approved checks=failure", details.String())
+}
+
+// mergeRecorder is a CodeHost that only knows about a single PR
+type mergeRecorder struct {
+	CodeHost
+	details *PullRequestDetails
+	merged  bool
+}
+
+func (m *mergeRecorder) PullRequestDetails(_ context.Context, _ string, _ string, _ int64) (*PullRequestDetails, error) {
+	return m.details, nil
+}
+
+func (m *mergeRecorder) MergePullRequest(_ context.Context, _ string, _ string, _ int64) error {
+	m.merged = true
+	return nil
+}
+
+func TestMergeRefusesFailingChecks(t *testing.T) {
+	ctx := context.Background()
+	dir := newCommittedRepo(t)
+	MustExec(t, pipe.NewPiped("git", "remote", "add", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	host := &mergeRecorder{
+		details: &PullRequestDetails{Number: 7, State: PullRequestStateOpen, Checks: CheckStateFailure},
+	}
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Git:      &GitCli{Logger: zap.NewNop(), Dir: dir},
+		CodeHost: host,
+	}
+	require.ErrorIs(t, f.MergePullRequestForCurrentRemote(ctx, 7), ErrChecksFailing)
+	require.False(t, host.merged)
+
+	host.details.Checks = CheckStatePending
+	host.details.Mergeable = MergeableStateConflicting
+	var unmergeable *UnmergeableError
+	require.ErrorAs(t, f.MergePullRequestForCurrentRemote(ctx, 7), &unmergeable)
+	require.Equal(t, UnmergeableConflicting, unmergeable.Reason)
+	require.False(t, host.merged)
+
+	host.details.Mergeable = MergeableStateUnknown
+	host.details.State = PullRequestStateMerged
+	require.ErrorIs(t, f.MergePullRequestForCurrentRemote(ctx, 7), ErrPullRequestNotOpen)
+	require.False(t, host.merged)
+
+	host.details.State = PullRequestStateOpen
+	require.NoError(t, f.MergePullRequestForCurrentRemote(ctx, 7))
+	require.True(t, host.merged)
+}
+
+// approveRecorder is a code host that only knows about a single promotion PR
+type approveRecorder struct {
+	CodeHost
+	details *PullRequestDetails
+	self    string
+	selfErr error
+	reviews []string
+}
+
+func (a *approveRecorder) PullRequestDetails(_ context.Context, _ string, _ string, _ int64) (*PullRequestDetails, error) {
+	return a.details, nil
+}
+
+func (a *approveRecorder) Self(_ context.Context) (string, error) {
+	return a.self, a.selfErr
+}
+
+func (a *approveRecorder) AcceptPullRequest(_ context.Context, approvalmessage string, _ string, _ string, _ int64) error {
+	a.reviews = append(a.reviews, approvalmessage)
+	return nil
+}
+
+func TestApprovePullRequestAs(t *testing.T) {
+	ctx := context.Background()
+	dir := newCommittedRepo(t)
+	MustExec(t, pipe.NewPiped("git", "remote", "add", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	host := &approveRecorder{
+		details: &PullRequestDetails{
+			Number:      7,
+			State:       PullRequestStateOpen,
+			Author:      "releaser-bot",
+			HeadMessage: "Promote app to prod\n\nReleaser-Application: app\nReleaser-To: prod\nReleaser-Actor: Alice Smith <alice@cresta.ai>\n",
+		},
+		self: "approver-bot",
+	}
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Git:      &GitCli{Logger: zap.NewNop(), Dir: dir},
+		CodeHost: host,
+	}
+	require.ErrorIs(t, f.ApprovePullRequestAs(ctx, "Releaser-Bot", "", 7), ErrSelfApproval)
+	require.ErrorIs(t, f.ApprovePullRequestAs(ctx, "alice@cresta.ai", "", 7), ErrSelfApproval)
+	host.self = "releaser-bot"
+	require.ErrorIs(t, f.ApprovePullRequestAs(ctx, "bob", "", 7), ErrSelfApproval)
+	host.self = "approver-bot"
+	host.details.State = PullRequestStateClosed
+	require.ErrorIs(t, f.ApprovePullRequestAs(ctx, "bob", "", 7), ErrPullRequestNotOpen)
+	host.details.State = PullRequestStateOpen
+	// Only credentials that cannot look themselves up skip the check
+	host.selfErr = errors.New("bad credentials")
+	require.Error(t, f.ApprovePullRequestAs(ctx, "bob", "", 7))
+	require.Empty(t, host.reviews)
+
+	host.selfErr = fmt.Errorf("resource not accessible: %w", ErrSelfUnavailable)
+	require.NoError(t, f.ApprovePullRequestAs(ctx, "bob", "Looks good", 7))
+	require.Equal(t, []string{"Looks good\n\nApproved on behalf of bob"}, host.reviews)
+}
+
+func TestSameIdentity(t *testing.T) {
+	require.True(t, SameIdentity("alice", "ALICE"))
+	require.True(t, SameIdentity("alice", "alice <alice@cresta.ai>"))
+	require.True(t, SameIdentity("Alice Smith <alice@cresta.ai>", "alice@cresta.ai"))
+	require.False(t, SameIdentity("alice", "Alice Smith <alice@cresta.ai>"))
+	require.False(t, SameIdentity("", ""))
+}
+
+func TestGithubEnableAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	var mutations []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(string(body), "enablePullRequestAutoMerge") {
+			mutations = append(mutations, string(body))
+			_, _ = io.WriteString(w, `{"data": {"enablePullRequestAutoMerge": {"pullRequest": {"id": "PR_abc"}}}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data": {"repository": {"pullRequest": {"id": "PR_abc"}}}}`)
+	}))
+	defer srv.Close()
+	gh := createGraphqlAPI(githubv4.NewEnterpriseClient(srv.URL, srv.Client()), zap.NewNop(), nil)
+	require.NoError(t, gh.EnablePullRequestAutoMerge(ctx, "cresta", "deploy", 123, MergeMethodRebase))
+	require.Len(t, mutations, 1)
+	require.Contains(t, mutations[0], `"mergeMethod":"REBASE"`)
+	require.Contains(t, mutations[0], `"pullRequestId":"PR_abc"`)
+
+	require.Error(t, gh.EnablePullRequestAutoMerge(ctx, "cresta", "deploy", 123, "fast-forward"))
+	require.Len(t, mutations, 1)
+}
+
+func TestGithubEnterpriseServer(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/graphql", r.URL.Path)
+		require.Equal(t, "Bearer ghes-token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data": {"viewer": {"login": "releaser"}}}`)
+	}))
+	defer srv.Close()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".config", "gh"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".config", "gh", "hosts.yml"), []byte("github.com:\n  oauth_token: dotcom-token\ngithub.example.com:\n  oauth_token: ghes-token\n"), 0600))
+	// Environment credentials would win over the gh CLI config
+	defaults := DefaultGQLClientConfig
+	DefaultGQLClientConfig = NewGQLClientConfig{Rt: http.DefaultTransport}
+	defer func() { DefaultGQLClientConfig = defaults }()
+
+	gh, err := NewGQLClient(ctx, zap.NewNop(), &NewGQLClientConfig{
+		BaseURL: srv.URL + "/",
+		Host:    "GitHub.example.com",
+	})
+	require.NoError(t, err)
+	self, err := gh.Self(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "releaser", self)
+
+	graphqlURL, restURL := githubAPIURLs("https://github.com")
+	require.Equal(t, "https://api.github.com/graphql", graphqlURL)
+	require.Equal(t, "https://api.github.com", restURL)
+	graphqlURL, restURL = githubAPIURLs("https://github.example.com/")
+	require.Equal(t, "https://github.example.com/api/graphql", graphqlURL)
+	require.Equal(t, "https://github.example.com/api/v3", restURL)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	var responses []func(w http.ResponseWriter)
+	responses = append(responses,
+		func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `{"message": "You have exceeded a secondary rate limit."}`)
+		},
+		func(w http.ResponseWriter) {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(30*time.Second).Unix(), 10))
+			_, _ = io.WriteString(w, `{"errors": [{"type": "RATE_LIMITED", "message": "API rate limit exceeded"}]}`)
+		},
+		func(w http.ResponseWriter) {
+			w.Header().Set("X-RateLimit-Remaining", "4999")
+			_, _ = io.WriteString(w, `{"data": {"viewer": {"login": "releaser"}}}`)
+		},
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), "viewer")
+		require.NotEmpty(t, responses)
+		w.Header().Set("Content-Type", "application/json")
+		responses[0](w)
+		responses = responses[1:]
+	}))
+	defer srv.Close()
+	var waits []time.Duration
+	rt := NewRateLimitTransport(srv.Client().Transport, zap.NewNop())
+	rt.now = func() time.Time { return now }
+	rt.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		now = now.Add(d)
+		return nil
+	}
+	gh := createGraphqlAPI(githubv4.NewEnterpriseClient(srv.URL, &http.Client{Transport: rt}), zap.NewNop(), nil)
+	self, err := gh.Self(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "releaser", self)
+	require.Equal(t, []time.Duration{7 * time.Second, 30 * time.Second}, waits)
+	require.Empty(t, responses)
+
+	// Requests without a body, like the GETs of the REST API, are retried too
+	limited := true
+	rest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		if limited {
+			limited = false
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = io.WriteString(w, `[]`)
+	}))
+	defer rest.Close()
+	waits = nil
+	resp, err := (&http.Client{Transport: rt}).Get(rest.URL + "/repos/cresta/deploy/deployments")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []time.Duration{2 * time.Second}, waits)
+}
+
+func TestGithubDeployments(t *testing.T) {
+	ctx := context.Background()
+	var created map[string]interface{}
+	creations := 0
+	var statuses []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/graphql":
+			_, _ = io.WriteString(w, `{"data": {"repository": {"pullRequest": {
+				"number": 42,
+				"merged": true,
+				"headRefName": "releaser-a1-01-staging",
+				"mergeCommit": {"oid": "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c"},
+				"commits": {"nodes": [{"commit": {"message": "cresta-releaser: a1:01-staging\n\nReleaser-Application: a1\nReleaser-From: 00-head\nReleaser-To: 01-staging\nReleaser-Source-Sha: 6113728f27ae82c7b1a177c8d03f9e96e0adf246"}}]}
+			}}}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/cresta/deploy/deployments":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			creations++
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id": 7, "sha": "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c", "environment": "staging", "payload": {"application": "a1", "release": "01-staging"}, "created_at": "2022-11-02T17:05:12Z"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/cresta/deploy/deployments/7/statuses":
+			var status map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+			statuses = append(statuses, status)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/cresta/deploy/deployments":
+			require.Equal(t, "staging", r.URL.Query().Get("environment"))
+			if creations == 0 {
+				_, _ = io.WriteString(w, `[]`)
+				return
+			}
+			_, _ = io.WriteString(w, `[
+				{"id": 7, "sha": "0d1a26e", "environment": "staging", "payload": {"application": "a1", "release": "01-staging", "pull_request": 42}, "created_at": "2022-11-02T17:05:12Z"},
+				{"id": 6, "sha": "6113728", "environment": "staging", "payload": "deployed by hand", "created_at": "2022-11-01T17:05:12Z"},
+				{"id": 5, "sha": "6113728", "environment": "staging", "payload": {"application": "a2", "release": "01-staging"}, "created_at": "2022-11-01T17:05:12Z"}
+			]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/cresta/deploy/deployments/7/statuses":
+			_, _ = io.WriteString(w, `[{"state": "success"}]`)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/statuses"):
+			_, _ = io.WriteString(w, `[]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	gh := createGraphqlAPI(githubv4.NewEnterpriseClient(srv.URL+"/graphql", srv.Client()), zap.NewNop(), nil)
+	gh.restClient = srv.Client()
+	gh.restURL = srv.URL
+	dir := t.TempDir()
+	MustExec(t, pipe.NewPiped("git", "init").WithDir(dir))
+	MustExec(t, pipe.NewPiped("git", "remote", "add", "origin", "https://github.com/cresta/deploy.git").WithDir(dir))
+	f := &FromCommandLine{
+		Logger:   zap.NewNop(),
+		Fs:       &OSFileSystem{Logger: zap.NewNop(), Root: dir},
+		Git:      &GitCli{Logger: zap.NewNop(), Dir: dir},
+		CodeHost: gh,
+	}
+
+	deployment, err := f.DeployPullRequest(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), deployment.ID)
+	require.Equal(t, DeploymentStateInProgress, deployment.State)
+	require.Equal(t, "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c", created["ref"])
+	require.Equal(t, "staging", created["environment"])
+	require.Equal(t, map[string]interface{}{
+		"application":  "a1",
+		"release":      "01-staging",
+		"from":         "00-head",
+		"original_sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		"pull_request": float64(42),
+	}, created["payload"])
+	require.Equal(t, []map[string]interface{}{{"state": "in_progress", "description": "Merged PR #42"}}, statuses)
+
+	// Deploying the same PR again, as a redelivered webhook does, returns the first deployment
+	again, err := f.DeployPullRequest(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), again.ID)
+	require.Equal(t, 1, creations)
+	require.Len(t, statuses, 1)
+
+	require.NoError(t, f.SetDeploymentStatus(ctx, 7, DeploymentStateSuccess, "rolled out"))
+	require.Equal(t, "success", statuses[1]["state"])
+	require.Error(t, f.SetDeploymentStatus(ctx, 7, "done", ""))
+
+	deployments, err := f.Deployments(ctx, "a1", "01-staging")
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	require.Equal(t, int64(7), deployments[0].ID)
+	require.Equal(t, DeploymentStateSuccess, deployments[0].State)
+}
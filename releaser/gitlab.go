@@ -0,0 +1,402 @@
+package releaser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// GitlabAPI manages merge requests through the GitLab REST API
+type GitlabAPI struct {
+	// BaseURL is the root of the GitLab instance, such as https://gitlab.com
+	BaseURL     string
+	Token       string
+	Client      *http.Client
+	Logger      *zap.Logger
+	findMrCache ExpireCache[findPrKey, findPrValue]
+	// tokens holds Token, so that health checks and invalidation see the same cache git authenticates with
+	tokens *TokenCache
+}
+
+type GitlabConfig struct {
+	// BaseURL is the root of the GitLab instance.  Defaults to https:// plus the host of the remote.
+	BaseURL string
+	Token   string
+	Rt      http.RoundTripper
+}
+
+var DefaultGitlabConfig = GitlabConfig{
+	Rt:      http.DefaultTransport,
+	BaseURL: os.Getenv("GITLAB_URL"),
+	Token:   os.Getenv("GITLAB_TOKEN"),
+}
+
+func mergeGitlabConfigs(cfg *GitlabConfig, config *GitlabConfig) *GitlabConfig {
+	if cfg == nil {
+		return config
+	}
+	ret := *cfg
+	if ret.Rt == nil {
+		ret.Rt = config.Rt
+	}
+	if ret.BaseURL == "" {
+		ret.BaseURL = config.BaseURL
+	}
+	if ret.Token == "" {
+		ret.Token = config.Token
+	}
+	return &ret
+}
+
+// NewGitlabClient creates a GitLab client.  remote picks the GitLab instance if the config has no base URL.
+func NewGitlabClient(logger *zap.Logger, cfg *GitlabConfig, remote *RemoteURL) (*GitlabAPI, error) {
+	cfg = mergeGitlabConfigs(cfg, &DefaultGitlabConfig)
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		if remote == nil || remote.Host == "" {
+			return nil, fmt.Errorf("no GitLab URL provided: I need either GITLAB_URL env or a remote to guess it from")
+		}
+		baseURL = "https://" + remote.Host
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("no token provided: I need GITLAB_TOKEN env")
+	}
+	AddSecret(cfg.Token)
+	rt := cfg.Rt
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &GitlabAPI{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Token:   cfg.Token,
+		Client:  &http.Client{Transport: DebugLogTransport(rt, logger)},
+		Logger:  logger,
+		findMrCache: ExpireCache[findPrKey, findPrValue]{
+			defaultExpiry: time.Minute,
+		},
+		tokens: StaticTokenCache(cfg.Token),
+	}, nil
+}
+
+// projectPath is the URL path of a project in the GitLab API.  Projects are referred to by their URL encoded full
+// path.
+func projectPath(owner string, name string) string {
+	return "/projects/" + url.PathEscape(owner+"/"+name)
+}
+
+type gitlabMergeRequest struct {
+	IID int64 `json:"iid"`
+}
+
+// do sends a request to the GitLab API, decoding the JSON response into out if out is not nil
+func (g *GitlabAPI) do(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	reqURL, err := url.Parse(g.BaseURL + "/api/v4" + path)
+	if err != nil {
+		return fmt.Errorf("unable to parse GitLab URL: %w", err)
+	}
+	reqURL.RawQuery = query.Encode()
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("unable to encode GitLab request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), body)
+	if err != nil {
+		return fmt.Errorf("unable to create GitLab request: %w", err)
+	}
+	token, err := g.tokens.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get GitLab token: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitLab request %s %s failed: %w", method, path, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read GitLab response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("GitLab request %s %s failed with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("unable to decode GitLab response: %w", err)
+	}
+	return nil
+}
+
+func (g *GitlabAPI) ForgetPullRequests() {
+	g.findMrCache.Clear()
+}
+
+func (g *GitlabAPI) CreatePullRequest(ctx context.Context, owner string, name string, baseRefName string, headRefName string, title string, body string) (int64, error) {
+	defer g.findMrCache.Clear()
+	g.Logger.Debug("creating merge request", zap.String("owner", owner), zap.String("name", name), zap.String("baseRefName", baseRefName), zap.String("headRefName", headRefName))
+	defer g.Logger.Debug("done creating merge request")
+	var ret gitlabMergeRequest
+	if err := g.do(ctx, http.MethodPost, projectPath(owner, name)+"/merge_requests", nil, map[string]string{
+		"source_branch": headRefName,
+		"target_branch": baseRefName,
+		"title":         title,
+		"description":   body,
+	}, &ret); err != nil {
+		return 0, fmt.Errorf("failed to create merge request: %w", err)
+	}
+	return ret.IID, nil
+}
+
+func (g *GitlabAPI) FindPRForBranch(ctx context.Context, owner string, name string, branch string) (int64, error) {
+	g.Logger.Debug("FindPRForBranch", zap.String("owner", owner), zap.String("name", name), zap.String("branch", branch))
+	defer g.Logger.Debug("Done FindPRForBranch")
+	cacheKey := findPrKey{
+		owner:  owner,
+		name:   name,
+		branch: branch,
+	}
+	if mr, exists := g.findMrCache.Get(cacheKey); exists {
+		return mr.number, nil
+	}
+	var mrs []gitlabMergeRequest
+	if err := g.do(ctx, http.MethodGet, projectPath(owner, name)+"/merge_requests", url.Values{
+		"state":         []string{"opened"},
+		"source_branch": []string{branch},
+	}, nil, &mrs); err != nil {
+		return 0, fmt.Errorf("failed to query for merge requests: %w", err)
+	}
+	if len(mrs) > 1 {
+		return 0, fmt.Errorf("found multiple merge requests for branch %s", branch)
+	}
+	var number int64
+	if len(mrs) == 1 {
+		number = mrs[0].IID
+	}
+	g.findMrCache.Set(cacheKey, findPrValue{number: number})
+	return number, nil
+}
+
+// gitlabPageSize is the number of results GitLab returns per page of a listing
+const gitlabPageSize = 100
+
+func (g *GitlabAPI) ListPullRequests(ctx context.Context, owner string, name string, headPrefix string) ([]PullRequestSummary, error) {
+	var ret []PullRequestSummary
+	for page := 1; ; page++ {
+		var mrs []struct {
+			IID          int64  `json:"iid"`
+			SourceBranch string `json:"source_branch"`
+		}
+		if err := g.do(ctx, http.MethodGet, projectPath(owner, name)+"/merge_requests", url.Values{
+			"state":    []string{"opened"},
+			"per_page": []string{strconv.Itoa(gitlabPageSize)},
+			"page":     []string{strconv.Itoa(page)},
+		}, nil, &mrs); err != nil {
+			return nil, fmt.Errorf("failed to list merge requests: %w", err)
+		}
+		for _, mr := range mrs {
+			if strings.HasPrefix(mr.SourceBranch, headPrefix) {
+				ret = append(ret, PullRequestSummary{
+					Number:      mr.IID,
+					HeadRefName: mr.SourceBranch,
+				})
+			}
+		}
+		if len(mrs) < gitlabPageSize {
+			return ret, nil
+		}
+	}
+}
+
+func (g *GitlabAPI) UpdatePullRequestBody(ctx context.Context, owner string, name string, number int64, body string) error {
+	mrPath := projectPath(owner, name) + "/merge_requests/" + strconv.FormatInt(number, 10)
+	if err := g.do(ctx, http.MethodPut, mrPath, nil, map[string]string{
+		"description": body,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to update merge request %d: %w", number, err)
+	}
+	return nil
+}
+
+func (g *GitlabAPI) ClosePullRequest(ctx context.Context, owner string, name string, number int64, comment string) error {
+	defer g.findMrCache.Clear()
+	mrPath := projectPath(owner, name) + "/merge_requests/" + strconv.FormatInt(number, 10)
+	if comment != "" {
+		if err := g.do(ctx, http.MethodPost, mrPath+"/notes", nil, map[string]string{
+			"body": comment,
+		}, nil); err != nil {
+			return fmt.Errorf("unable to comment on merge request %d: %w", number, err)
+		}
+	}
+	if err := g.do(ctx, http.MethodPut, mrPath, nil, map[string]string{
+		"state_event": "close",
+	}, nil); err != nil {
+		return fmt.Errorf("unable to close merge request %d: %w", number, err)
+	}
+	return nil
+}
+
+func (g *GitlabAPI) PullRequestDetails(ctx context.Context, owner string, name string, number int64) (*PullRequestDetails, error) {
+	mrPath := projectPath(owner, name) + "/merge_requests/" + strconv.FormatInt(number, 10)
+	var mr struct {
+		IID                 int64  `json:"iid"`
+		State               string `json:"state"`
+		MergeStatus         string `json:"merge_status"`
+		DetailedMergeStatus string `json:"detailed_merge_status"`
+		HasConflicts        bool   `json:"has_conflicts"`
+		Draft               bool   `json:"draft"`
+		Author              struct {
+			Username string `json:"username"`
+		} `json:"author"`
+		HeadPipeline *struct {
+			Status string `json:"status"`
+		} `json:"head_pipeline"`
+	}
+	if err := g.do(ctx, http.MethodGet, mrPath, nil, nil, &mr); err != nil {
+		return nil, fmt.Errorf("unable to fetch merge request %d: %w", number, err)
+	}
+	var approvals struct {
+		Approved      bool `json:"approved"`
+		ApprovalsLeft int  `json:"approvals_left"`
+		ApprovedBy    []struct {
+			User struct {
+				Username string `json:"username"`
+			} `json:"user"`
+		} `json:"approved_by"`
+	}
+	if err := g.do(ctx, http.MethodGet, mrPath+"/approvals", nil, nil, &approvals); err != nil {
+		return nil, fmt.Errorf("unable to fetch approvals of merge request %d: %w", number, err)
+	}
+	// Commits are listed newest first
+	var commits []struct {
+		Message string `json:"message"`
+	}
+	if err := g.do(ctx, http.MethodGet, mrPath+"/commits", url.Values{"per_page": []string{"1"}}, nil, &commits); err != nil {
+		return nil, fmt.Errorf("unable to fetch commits of merge request %d: %w", number, err)
+	}
+	ret := &PullRequestDetails{
+		Number:    mr.IID,
+		Author:    mr.Author.Username,
+		Draft:     mr.Draft,
+		Approvals: len(approvals.ApprovedBy),
+		Mergeable: MergeableStateUnknown,
+	}
+	if len(commits) > 0 {
+		ret.HeadMessage = commits[0].Message
+	}
+	switch mr.State {
+	case "opened", "locked":
+		ret.State = PullRequestStateOpen
+	case "merged":
+		ret.State = PullRequestStateMerged
+	default:
+		ret.State = PullRequestStateClosed
+	}
+	switch {
+	case approvals.ApprovalsLeft > 0:
+		ret.ReviewDecision = ReviewDecisionReviewRequired
+	case len(approvals.ApprovedBy) > 0:
+		ret.ReviewDecision = ReviewDecisionApproved
+	}
+	switch {
+	case mr.HasConflicts:
+		ret.Mergeable = MergeableStateConflicting
+	case mr.MergeStatus == "can_be_merged":
+		ret.Mergeable = MergeableStateMergeable
+	case mr.MergeStatus == "cannot_be_merged" && mr.DetailedMergeStatus == "conflict":
+		ret.Mergeable = MergeableStateConflicting
+	}
+	if mr.HeadPipeline != nil {
+		switch mr.HeadPipeline.Status {
+		case "success":
+			ret.Checks = CheckStateSuccess
+		case "failed", "canceled":
+			ret.Checks = CheckStateFailure
+		case "skipped":
+		default:
+			ret.Checks = CheckStatePending
+		}
+	}
+	return ret, nil
+}
+
+func (g *GitlabAPI) Self(ctx context.Context) (string, error) {
+	var user struct {
+		Username string `json:"username"`
+	}
+	if err := g.do(ctx, http.MethodGet, "/user", nil, nil, &user); err != nil {
+		return "", fmt.Errorf("unable to fetch current user: %w", err)
+	}
+	return user.Username, nil
+}
+
+func (g *GitlabAPI) AcceptPullRequest(ctx context.Context, approvalmessage string, owner string, name string, number int64) error {
+	defer g.findMrCache.Clear()
+	mrPath := projectPath(owner, name) + "/merge_requests/" + strconv.FormatInt(number, 10)
+	if err := g.do(ctx, http.MethodPost, mrPath+"/approve", nil, nil, nil); err != nil {
+		return fmt.Errorf("unable to approve merge request %d: %w", number, err)
+	}
+	// Approvals on GitLab have no message, so leave it as a comment
+	if approvalmessage != "" {
+		if err := g.do(ctx, http.MethodPost, mrPath+"/notes", nil, map[string]string{
+			"body": approvalmessage,
+		}, nil); err != nil {
+			return fmt.Errorf("unable to comment on merge request %d: %w", number, err)
+		}
+	}
+	return nil
+}
+
+func (g *GitlabAPI) MergePullRequest(ctx context.Context, owner string, name string, number int64) error {
+	defer g.findMrCache.Clear()
+	mrPath := projectPath(owner, name) + "/merge_requests/" + strconv.FormatInt(number, 10)
+	if err := g.do(ctx, http.MethodPut, mrPath+"/merge", nil, map[string]bool{
+		"squash": true,
+	}, nil); err != nil {
+		return fmt.Errorf("unable to merge merge request %d: %w", number, err)
+	}
+	return nil
+}
+
+func (g *GitlabAPI) DefaultBranch(ctx context.Context, owner string, name string) (string, error) {
+	var project struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := g.do(ctx, http.MethodGet, projectPath(owner, name), nil, nil, &project); err != nil {
+		return "", fmt.Errorf("unable to fetch project %s/%s: %w", owner, name, err)
+	}
+	if project.DefaultBranch == "" {
+		return "", fmt.Errorf("project %s/%s has no default branch", owner, name)
+	}
+	return project.DefaultBranch, nil
+}
+
+func (g *GitlabAPI) GetAccessToken(ctx context.Context) (string, error) {
+	return g.tokens.Token(ctx)
+}
+
+func (g *GitlabAPI) AccessTokens() *TokenCache {
+	return g.tokens
+}
+
+var _ CodeHost = &GitlabAPI{}
@@ -0,0 +1,123 @@
+package releaser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGitlabAPI(t *testing.T) {
+	ctx := context.Background()
+	const project = "/api/v4/projects/group%2Fsub%2Fproj"
+	var openMrs []gitlabMergeRequest
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		var body map[string]interface{}
+		if r.Body != nil && r.ContentLength > 0 {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		}
+		var resp interface{} = map[string]string{}
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /api/v4/user":
+			resp = map[string]string{"username": "releaser-bot"}
+		case "GET " + project:
+			resp = map[string]string{"default_branch": "main"}
+		case "GET " + project + "/merge_requests":
+			require.Equal(t, "opened", r.URL.Query().Get("state"))
+			require.Equal(t, "releaser-app-prod", r.URL.Query().Get("source_branch"))
+			resp = openMrs
+		case "POST " + project + "/merge_requests":
+			require.Equal(t, "releaser-app-prod", body["source_branch"])
+			require.Equal(t, "main", body["target_branch"])
+			openMrs = append(openMrs, gitlabMergeRequest{IID: 7})
+			resp = gitlabMergeRequest{IID: 7}
+		case "POST " + project + "/merge_requests/7/approve":
+		case "POST " + project + "/merge_requests/7/notes":
+			require.Equal(t, "looks good", body["body"])
+		case "GET " + project + "/merge_requests/7":
+			resp = map[string]interface{}{
+				"iid":           7,
+				"state":         "opened",
+				"merge_status":  "can_be_merged",
+				"head_pipeline": map[string]string{"status": "running"},
+			}
+		case "GET " + project + "/merge_requests/7/approvals":
+			resp = map[string]interface{}{
+				"approvals_left": 0,
+				"approved_by":    []interface{}{map[string]interface{}{"user": map[string]string{"username": "reviewer"}}},
+			}
+		case "GET " + project + "/merge_requests/7/commits":
+			require.Equal(t, "1", r.URL.Query().Get("per_page"))
+			resp = []map[string]string{{"message": "cresta-releaser: app:prod"}}
+		case "PUT " + project + "/merge_requests/7/merge":
+			require.Equal(t, true, body["squash"])
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	host, err := NewCodeHost(ctx, zap.NewNop(), &CodeHostConfig{
+		Remote: &RemoteURL{Host: "gitlab.example.com", Owner: "group/sub", Name: "proj"},
+		Gitlab: &GitlabConfig{BaseURL: srv.URL + "/", Token: "secret"},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &GitlabAPI{}, host)
+
+	self, err := host.Self(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "releaser-bot", self)
+
+	// The cache is shared, so a rejected token shows up in health checks
+	tokens := host.AccessTokens()
+	require.Same(t, tokens, host.AccessTokens())
+	tokens.Invalidate("secret")
+	require.False(t, host.AccessTokens().Health().Healthy)
+	token, err := host.GetAccessToken(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "secret", token)
+	require.True(t, tokens.Health().Healthy)
+
+	branch, err := host.DefaultBranch(ctx, "group/sub", "proj")
+	require.NoError(t, err)
+	require.Equal(t, "main", branch)
+
+	number, err := host.FindPRForBranch(ctx, "group/sub", "proj", "releaser-app-prod")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), number)
+
+	number, err = host.CreatePullRequest(ctx, "group/sub", "proj", "main", "releaser-app-prod", "title", "body")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), number)
+
+	number, err = host.FindPRForBranch(ctx, "group/sub", "proj", "releaser-app-prod")
+	require.NoError(t, err)
+	require.Equal(t, int64(7), number)
+
+	details, err := host.PullRequestDetails(ctx, "group/sub", "proj", 7)
+	require.NoError(t, err)
+	require.Equal(t, &PullRequestDetails{
+		Number:         7,
+		State:          PullRequestStateOpen,
+		ReviewDecision: ReviewDecisionApproved,
+		Approvals:      1,
+		Mergeable:      MergeableStateMergeable,
+		Checks:         CheckStatePending,
+		HeadMessage:    "cresta-releaser: app:prod",
+	}, details)
+
+	require.NoError(t, host.AcceptPullRequest(ctx, "looks good", "group/sub", "proj", 7))
+	require.NoError(t, host.MergePullRequest(ctx, "group/sub", "proj", 7))
+	require.Contains(t, requests, "PUT "+project+"/merge_requests/7/merge")
+
+	require.Error(t, host.MergePullRequest(ctx, "group/sub", "proj", 8))
+}
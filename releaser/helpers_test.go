@@ -61,8 +61,11 @@ func (d *RepositoryLayout) WithLayout(ctx context.Context, t *testing.T, innerFu
 	l := zap.NewProductionConfig()
 	logger, err := l.Build()
 	require.NoError(t, err)
-	inst, err := NewFromCommandLine(ctx, logger, &NewGQLClientConfig{
-		Token: "unset",
+	inst, err := NewFromCommandLine(ctx, logger, &CodeHostConfig{
+		Provider: ProviderGithub,
+		Github: &NewGQLClientConfig{
+			Token: "unset",
+		},
 	})
 	require.NoError(t, err)
 	innerFunction(inst)
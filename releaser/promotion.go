@@ -0,0 +1,322 @@
+package releaser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig"
+	"go.uber.org/zap"
+)
+
+// Git trailers the releaser adds to promotion commits, so promotions can be rebuilt from git history alone
+const (
+	TrailerApplication = "Releaser-Application"
+	TrailerFrom        = "Releaser-From"
+	TrailerTo          = "Releaser-To"
+	TrailerSourceSha   = "Releaser-Source-Sha"
+	TrailerActor       = "Releaser-Actor"
+)
+
+// directPushAttempts is how many times CommitDirect pushes before giving up on a busy default branch
+const directPushAttempts = 5
+
+// DefaultCommitMessageTemplate is the message of promotion commits, before trailers are added.  It is executed
+// with a Promotion.
+const DefaultCommitMessageTemplate = `cresta-releaser: {{ .Application }}:{{ .To }}
+
+Promote {{ .Application }} from {{ .From }} to {{ .To }}.
+`
+
+// A Promotion is the copy of one release of an application into the next release
+type Promotion struct {
+	Application string `json:"application"`
+	// From is the release that was copied
+	From string `json:"from"`
+	// To is the release that was updated
+	To string `json:"to"`
+	// SourceSha is the git SHA the promoted content was originally released at
+	SourceSha string `json:"source_sha"`
+	// Actor is who asked for the promotion
+	Actor string `json:"actor"`
+}
+
+// PromotionEvent is a Promotion recorded in a commit
+type PromotionEvent struct {
+	Promotion
+	Sha  string    `json:"sha"`
+	Time time.Time `json:"time"`
+}
+
+// Trailers returns the git trailers that describe this promotion.  Empty values are left out.
+func (p *Promotion) Trailers() string {
+	var ret strings.Builder
+	for _, t := range []struct {
+		key   string
+		value string
+	}{
+		{TrailerApplication, p.Application},
+		{TrailerFrom, p.From},
+		{TrailerTo, p.To},
+		{TrailerSourceSha, p.SourceSha},
+		{TrailerActor, p.Actor},
+	} {
+		if t.value == "" {
+			continue
+		}
+		// Trailer values are a single line
+		value := strings.Join(strings.Fields(t.value), " ")
+		_, _ = fmt.Fprintf(&ret, "%s: %s\n", t.key, value)
+	}
+	return ret.String()
+}
+
+// CommitMessage renders the promotion using the go template tmpl, or DefaultCommitMessageTemplate if tmpl is empty,
+// and appends the promotion's trailers
+func (p *Promotion) CommitMessage(tmpl string) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultCommitMessageTemplate
+	}
+	t, err := template.New("commit").Funcs(sprig.TxtFuncMap()).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse commit message template: %w", err)
+	}
+	var msg strings.Builder
+	if err := t.Execute(&msg, p); err != nil {
+		return "", fmt.Errorf("unable to execute commit message template: %w", err)
+	}
+	body := strings.TrimSpace(msg.String())
+	if body == "" {
+		return "", fmt.Errorf("commit message template rendered an empty message")
+	}
+	return body + "\n\n" + p.Trailers(), nil
+}
+
+// ParsePromotion reads the releaser trailers back out of a commit message.  It returns false if the message is not
+// from a promotion.
+func ParsePromotion(message string) (*Promotion, bool) {
+	var ret Promotion
+	fields := map[string]*string{
+		TrailerApplication: &ret.Application,
+		TrailerFrom:        &ret.From,
+		TrailerTo:          &ret.To,
+		TrailerSourceSha:   &ret.SourceSha,
+		TrailerActor:       &ret.Actor,
+	}
+	// Like git interpret-trailers, only the last paragraph holds trailers.  Messages that quote another promotion, such
+	// as reverts or squash merges of several PRs, carry its trailers in earlier paragraphs.
+	for _, line := range strings.Split(lastParagraph(message), "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		field, exists := fields[strings.TrimSpace(key)]
+		if !exists {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		// Conflicting trailers mean the message describes more than one promotion
+		if *field != "" && *field != value {
+			return nil, false
+		}
+		*field = value
+	}
+	if ret.Application == "" || ret.To == "" {
+		return nil, false
+	}
+	return &ret, true
+}
+
+// lastParagraph returns the last block of non blank lines of a commit message
+func lastParagraph(message string) string {
+	lines := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n")
+	start := len(lines)
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+	return strings.Join(lines[start:], "\n")
+}
+
+// PromotionHistory returns the promotions recorded in the history of ref, newest first.  An empty application returns
+// the promotions of every application, and a limit of zero returns every promotion.
+func PromotionHistory(ctx context.Context, g Git, ref string, application string, limit int) ([]PromotionEvent, error) {
+	grep := "^" + TrailerApplication + ":"
+	if application != "" {
+		grep += " *" + regexp.QuoteMeta(application) + " *$"
+	}
+	commits, err := g.Log(ctx, ref, limit, grep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read git log of %s: %w", ref, err)
+	}
+	ret := make([]PromotionEvent, 0, len(commits))
+	for _, c := range commits {
+		p, ok := ParsePromotion(c.Message)
+		if !ok {
+			continue
+		}
+		ret = append(ret, PromotionEvent{
+			Promotion: *p,
+			Sha:       c.Sha,
+			Time:      c.Time,
+		})
+	}
+	return ret, nil
+}
+
+func (f *FromCommandLine) PromotionHistory(ctx context.Context, ref string, application string, limit int) ([]PromotionEvent, error) {
+	return PromotionHistory(ctx, f.Git, ref, application, limit)
+}
+
+// promotionForRelease describes the promotion of an already applied release
+func (f *FromCommandLine) promotionForRelease(ctx context.Context, application string, release string) (*Promotion, error) {
+	releases, err := f.ListReleases(application)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	ret := &Promotion{
+		Application: application,
+		To:          release,
+		Actor:       f.Actor,
+	}
+	if idx := indexOf(release, releases); idx > 0 {
+		ret.From = releases[idx-1]
+	}
+	rel, err := f.GetRelease(application, release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %s:%s: %w", application, release, err)
+	}
+	cfg, err := rel.loadReleaseConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load release config for %s:%s: %w", application, release, err)
+	}
+	ret.SourceSha = cfg.Metadata.OriginalRelease.GitSha
+	if ret.Actor == "" {
+		if ret.Actor, err = f.Git.AuthorIdentity(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get git author: %w", err)
+		}
+	}
+	return ret, nil
+}
+
+// CommitDirect requires a clean checkout, which it moves to the tip of the default branch on origin.  The promotion is
+// committed on top and pushed.  If the default branch moves in the meantime, the commit is rebased onto it and pushed
+// again.
+func (f *FromCommandLine) CommitDirect(ctx context.Context, application string, release string) (string, error) {
+	if f.CommitThroughGithub {
+		return "", fmt.Errorf("direct promotions cannot be committed through GitHub")
+	}
+	if changes, err := f.Git.AreThereUncommittedChanges(ctx); err != nil {
+		return "", fmt.Errorf("failed to check for uncommitted changes: %w", err)
+	} else if changes {
+		return "", fmt.Errorf("there are uncommitted changes")
+	}
+	defaultBranch, err := f.Git.DefaultBranch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get default branch: %w", err)
+	}
+	if err := f.Git.FetchBranch(ctx, defaultBranch); err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %w", defaultBranch, err)
+	}
+	if err := f.Git.CheckoutDetached(ctx, "origin/"+defaultBranch); err != nil {
+		return "", fmt.Errorf("failed to checkout %s: %w", defaultBranch, err)
+	}
+	// Every promotion updates the release metadata, so only commit if something besides the metadata changes
+	if needed, err := NeedsPromotion(ctx, f, application, release); err != nil {
+		return "", fmt.Errorf("failed to check if %s:%s needs promotion: %w", application, release, err)
+	} else if !needed {
+		return "", nil
+	}
+	oldRelease, newRelease, err := f.PreviewRelease(ctx, application, release, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to preview release: %w", err)
+	}
+	if err := f.ApplyRelease(application, release, oldRelease, newRelease); err != nil {
+		return "", fmt.Errorf("failed to apply release: %w", err)
+	}
+	if err := f.CommitForRelease(ctx, application, release); err != nil {
+		return "", fmt.Errorf("failed to commit release: %w", err)
+	}
+	for attempt := 1; ; attempt++ {
+		err := f.Git.PushHead(ctx, "origin", defaultBranch)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, ErrNonFastForward) || attempt >= directPushAttempts {
+			return "", fmt.Errorf("failed to push to %s: %w", defaultBranch, err)
+		}
+		f.Logger.Info("default branch moved, rebasing", zap.String("branch", defaultBranch), zap.Int("attempt", attempt))
+		if err := f.Git.FetchBranch(ctx, defaultBranch); err != nil {
+			return "", fmt.Errorf("failed to fetch %s: %w", defaultBranch, err)
+		}
+		if err := f.Git.Rebase(ctx, "origin/"+defaultBranch); err != nil {
+			return "", fmt.Errorf("failed to rebase onto %s: %w", defaultBranch, err)
+		}
+	}
+	sha, err := f.Git.CurrentGitSha(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current git sha: %w", err)
+	}
+	return sha, nil
+}
+
+// ReleaseBranchDiffers fetches the promotion branch of a release, and compares its release with the one in the working
+// tree.  Like NeedsPromotion, the release's .releaser.yaml is ignored, since its metadata changes on every promotion.  A
+// branch that does not exist on origin differs.
+func (f *FromCommandLine) ReleaseBranchDiffers(ctx context.Context, application string, release string) (bool, error) {
+	branch := DefaultBranchNameForRelease(application, release)
+	remoteSha, err := f.Git.RemoteBranchSha(ctx, branch)
+	if err != nil {
+		return false, fmt.Errorf("failed to get remote sha of %s: %w", branch, err)
+	}
+	if remoteSha == "" {
+		return true, nil
+	}
+	if err := f.Git.FetchBranch(ctx, branch); err != nil {
+		return false, fmt.Errorf("failed to fetch %s: %w", branch, err)
+	}
+	releaseDir := filepath.ToSlash(filepath.Join("apps", application, "releases", release))
+	changed, err := f.Git.ChangedFiles(ctx, remoteSha, "", releaseDir)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare %s with the working tree: %w", branch, err)
+	}
+	for _, c := range changed {
+		if c != releaseDir+"/"+releaserFileName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdatePullRequestCurrent updates the body of the PR for the current branch to describe the commit at HEAD, and the
+// default branch commit it was built from
+func (f *FromCommandLine) UpdatePullRequestCurrent(ctx context.Context, prNumber int64) error {
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to parse remote URL: %w", err)
+	}
+	head, err := f.Git.Log(ctx, "HEAD", 1, "")
+	if err != nil {
+		return fmt.Errorf("failed to read HEAD commit: %w", err)
+	}
+	if len(head) == 0 {
+		return fmt.Errorf("no commit at HEAD")
+	}
+	defaultBranch, err := f.Git.DefaultBranch(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get default branch: %w", err)
+	}
+	baseSha, err := f.Git.RevParse(ctx, "origin/"+defaultBranch)
+	if err != nil {
+		return fmt.Errorf("unable to get sha of %s: %w", defaultBranch, err)
+	}
+	body := fmt.Sprintf("Deployment\n\n%s\n\nUpdated by cresta-releaser from %s at %s.", strings.TrimSpace(head[0].Message), defaultBranch, baseSha)
+	if err := f.CodeHost.UpdatePullRequestBody(ctx, owner, repo, prNumber, body); err != nil {
+		return fmt.Errorf("unable to update pull request %d: %w", prNumber, err)
+	}
+	return nil
+}
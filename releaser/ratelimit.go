@@ -0,0 +1,168 @@
+package releaser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// rateLimitAttempts is how many times a request is sent before a rate limited response is returned to the caller
+	rateLimitAttempts = 3
+	// defaultRateLimitMaxWait is the longest RateLimitTransport sleeps for a single request
+	defaultRateLimitMaxWait = 5 * time.Minute
+	// secondaryRateLimitWait is how long GitHub asks clients to back off from a secondary rate limit that does not
+	// say when to retry
+	secondaryRateLimitWait = time.Minute
+)
+
+// RateLimitTransport waits out GitHub rate limits instead of failing.  It tracks the primary rate limit from response
+// headers and pauses until it resets once it is used up.  Requests rejected by the primary or secondary rate limit are
+// retried after the wait GitHub asks for.
+type RateLimitTransport struct {
+	Base   http.RoundTripper
+	Logger *zap.Logger
+	// MaxWait is the longest the transport sleeps for one request.  Requests that would need a longer wait fail.
+	MaxWait time.Duration
+
+	// sleep and now are replaced by tests
+	sleep func(ctx context.Context, d time.Duration) error
+	now   func() time.Time
+
+	mu sync.Mutex
+	// exhaustedUntil is when the primary rate limit resets, if it is used up
+	exhaustedUntil time.Time
+}
+
+func NewRateLimitTransport(base http.RoundTripper, logger *zap.Logger) *RateLimitTransport {
+	return &RateLimitTransport{
+		Base:    base,
+		Logger:  logger,
+		MaxWait: defaultRateLimitMaxWait,
+	}
+}
+
+func (t *RateLimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	for attempt := 1; ; attempt++ {
+		if err := t.waitForReset(ctx); err != nil {
+			return nil, err
+		}
+		req := request
+		if attempt > 1 {
+			req = request.Clone(ctx)
+			// Requests without a body, such as GETs, are sent again as they are
+			if request.Body != nil && request.GetBody != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("failed to rewind request body: %w", err)
+				}
+				req.Body = body
+			}
+		}
+		resp, err := t.Base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		wait, limited, err := t.observe(resp)
+		if err != nil {
+			return nil, err
+		}
+		canRetry := request.Body == nil || request.GetBody != nil
+		if !limited || !canRetry || attempt >= rateLimitAttempts || wait > t.MaxWait {
+			return resp, nil
+		}
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+		t.Logger.Warn("rate limited by GitHub, backing off", zap.Duration("wait", wait), zap.Int("attempt", attempt))
+		if err := t.doSleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForReset sleeps until the primary rate limit resets, if it is used up
+func (t *RateLimitTransport) waitForReset(ctx context.Context) error {
+	t.mu.Lock()
+	wait := t.exhaustedUntil.Sub(t.clock())
+	until := t.exhaustedUntil
+	t.mu.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+	if wait > t.MaxWait {
+		return fmt.Errorf("github rate limit is used up until %s", until.Format(time.RFC3339))
+	}
+	t.Logger.Warn("github rate limit used up, waiting for reset", zap.Duration("wait", wait))
+	return t.doSleep(ctx, wait)
+}
+
+// observe records the primary rate limit of a response, and returns how long to wait if the request was rate limited
+func (t *RateLimitTransport) observe(resp *http.Response) (time.Duration, bool, error) {
+	var resetWait time.Duration
+	exhausted := resp.Header.Get("X-RateLimit-Remaining") == "0"
+	if exhausted {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			until := time.Unix(reset, 0)
+			resetWait = until.Sub(t.clock())
+			t.mu.Lock()
+			t.exhaustedUntil = until
+			t.mu.Unlock()
+		}
+	}
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && isRateLimitStatus(resp.StatusCode) {
+		return time.Duration(retryAfter) * time.Second, true, nil
+	}
+	if isRateLimitStatus(resp.StatusCode) || (exhausted && resp.StatusCode == http.StatusOK) {
+		// The GraphQL API reports rate limits inside successful responses, and both APIs explain 403s in the body
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to read response body: %w", err)
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		switch {
+		case exhausted && (resp.StatusCode != http.StatusOK || bytes.Contains(body, []byte("RATE_LIMITED"))):
+			return resetWait, true, nil
+		case resp.StatusCode != http.StatusOK && strings.Contains(strings.ToLower(string(body)), "secondary rate limit"):
+			return secondaryRateLimitWait, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func isRateLimitStatus(code int) bool {
+	return code == http.StatusForbidden || code == http.StatusTooManyRequests
+}
+
+func (t *RateLimitTransport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *RateLimitTransport) doSleep(ctx context.Context, d time.Duration) error {
+	if t.sleep != nil {
+		return t.sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var _ http.RoundTripper = &RateLimitTransport{}
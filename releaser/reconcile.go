@@ -0,0 +1,149 @@
+package releaser
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ReconciledPullRequest is a promotion PR that ReconcilePullRequests closed
+type ReconciledPullRequest struct {
+	Number int64  `json:"number"`
+	Branch string `json:"branch"`
+	Reason string `json:"reason"`
+}
+
+func (r *ReconciledPullRequest) MarshalText() (text []byte, err error) {
+	return []byte(fmt.Sprintf("%d %s %s", r.Number, r.Branch, r.Reason)), nil
+}
+
+// ReconcilePullRequests finds open PRs on releaser branches that are obsolete: either their release no longer needs
+// a promotion, for example because someone promoted it by hand or the upstream change was reverted, or their release
+// no longer exists.  Those PRs are closed with a comment that explains why, and their branches are deleted.  PRs with
+// commits that the releaser did not create are left alone.
+func (f *FromCommandLine) ReconcilePullRequests(ctx context.Context, dryRun bool) ([]ReconciledPullRequest, error) {
+	owner, repo, err := f.Git.GetRemoteAsGithubRepo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote repo: %w", err)
+	}
+	prs, err := f.CodeHost.ListPullRequests(ctx, owner, repo, ReleaseBranchPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+	type target struct {
+		application string
+		release     string
+	}
+	targets := make(map[string]target)
+	apps, err := f.ListApplications()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	for _, app := range apps {
+		releases, err := f.ListReleases(app)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases of %s: %w", app, err)
+		}
+		for idx, release := range releases {
+			// The first release is never promoted into
+			if idx == 0 {
+				continue
+			}
+			targets[DefaultBranchNameForRelease(app, release)] = target{application: app, release: release}
+		}
+	}
+	var ret []ReconciledPullRequest
+	for _, pr := range prs {
+		t, exists := targets[pr.HeadRefName]
+		unlock := func() {}
+		if exists {
+			// Promotions of the application may be pushing this branch, or opening a PR for it, right now
+			unlock = lockApplication(ctx, t.application)
+		}
+		reconciled, err := f.reconcilePullRequest(ctx, owner, repo, pr, t.application, t.release, exists, dryRun)
+		unlock()
+		if err != nil {
+			return nil, err
+		}
+		if reconciled != nil {
+			ret = append(ret, *reconciled)
+		}
+	}
+	return ret, nil
+}
+
+// reconcilePullRequest closes pr if it is obsolete, and returns it.  It returns nil if pr was left open.
+func (f *FromCommandLine) reconcilePullRequest(ctx context.Context, owner string, repo string, pr PullRequestSummary, application string, release string, releaseExists bool, dryRun bool) (*ReconciledPullRequest, error) {
+	logger := f.Logger.With(zap.Int64("pr", pr.Number), zap.String("branch", pr.HeadRefName))
+	var reason string
+	if !releaseExists {
+		reason = "The release this PR promotes into no longer exists."
+	} else if needed, err := NeedsPromotion(ctx, f, application, release); err != nil {
+		return nil, fmt.Errorf("failed to check if %s:%s needs promotion: %w", application, release, err)
+	} else if !needed {
+		reason = fmt.Sprintf("%s:%s is already up to date with the release it is promoted from, so this PR is no longer needed.", application, release)
+	}
+	if reason == "" {
+		return nil, nil
+	}
+	remoteSha, err := f.Git.RemoteBranchSha(ctx, pr.HeadRefName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote sha of %s: %w", pr.HeadRefName, err)
+	}
+	if remoteSha != "" {
+		foreign, err := f.foreignCommits(ctx, pr.HeadRefName, remoteSha)
+		if err != nil {
+			return nil, err
+		}
+		if len(foreign) > 0 {
+			logger.Info("leaving obsolete PR open: it has commits the releaser did not create", zap.Int("foreign_commits", len(foreign)))
+			return nil, nil
+		}
+	}
+	ret := &ReconciledPullRequest{
+		Number: pr.Number,
+		Branch: pr.HeadRefName,
+		Reason: reason,
+	}
+	if dryRun {
+		return ret, nil
+	}
+	logger.Info("closing obsolete PR", zap.String("reason", reason))
+	if err := f.CodeHost.ClosePullRequest(ctx, owner, repo, pr.Number, "Closed by cresta-releaser: "+reason); err != nil {
+		return nil, fmt.Errorf("failed to close PR %d: %w", pr.Number, err)
+	}
+	if remoteSha == "" {
+		return ret, nil
+	}
+	// Someone outside of this process may have pushed the branch since it was checked
+	if currentSha, err := f.Git.RemoteBranchSha(ctx, pr.HeadRefName); err != nil {
+		return nil, fmt.Errorf("failed to get remote sha of %s: %w", pr.HeadRefName, err)
+	} else if currentSha != remoteSha {
+		logger.Warn("leaving branch of closed PR: it moved while the PR was closed", zap.String("checked", remoteSha), zap.String("current", currentSha))
+		return ret, nil
+	}
+	if err := f.Git.DeleteRemoteBranch(ctx, pr.HeadRefName); err != nil {
+		return nil, fmt.Errorf("failed to delete branch of PR %d: %w", pr.Number, err)
+	}
+	return ret, nil
+}
+
+type applicationLockKey struct{}
+
+// WithApplicationLock returns a context that makes ReconcilePullRequests hold lock for an application while it checks
+// and closes the PRs of that application, so it does not race promotions of the same application.  lock returns the
+// function that unlocks.
+func WithApplicationLock(ctx context.Context, lock func(application string) func()) context.Context {
+	return context.WithValue(ctx, applicationLockKey{}, lock)
+}
+
+func lockApplication(ctx context.Context, application string) func() {
+	if lock, ok := ctx.Value(applicationLockKey{}).(func(application string) func()); ok {
+		return lock(application)
+	}
+	return func() {}
+}
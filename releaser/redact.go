@@ -0,0 +1,133 @@
+package releaser
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted replaces secrets removed by Redact
+const redacted = "[REDACTED]"
+
+// maxSecrets bounds how many secrets are remembered.  Tokens rotate, so the oldest are forgotten first.
+const maxSecrets = 64
+
+var secrets struct {
+	mu     sync.RWMutex
+	values []string
+}
+
+// secretPatterns match credentials that were never passed to AddSecret: passwords inside URLs, the prefixed tokens of
+// GitHub and GitLab, and authorization headers
+var secretPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^/:@\s]*:)[^/@\s]+@`), "${1}" + redacted + "@"},
+	{regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,}|glpat-[A-Za-z0-9_-]{20,})`), redacted},
+	{regexp.MustCompile(`(?i)(authorization:\s*(?:bearer|token|basic)\s+)\S+`), "${1}" + redacted},
+}
+
+// AddSecret makes Redact remove secret wherever it appears
+func AddSecret(secret string) {
+	// Very short values would remove too much unrelated text
+	if len(secret) < 8 {
+		return
+	}
+	secrets.mu.Lock()
+	defer secrets.mu.Unlock()
+	for _, s := range secrets.values {
+		if s == secret {
+			return
+		}
+	}
+	secrets.values = append(secrets.values, secret)
+	if len(secrets.values) > maxSecrets {
+		secrets.values = secrets.values[len(secrets.values)-maxSecrets:]
+	}
+}
+
+// Redact removes access tokens and other credentials from s, so it can be logged or returned in an error
+func Redact(s string) string {
+	secrets.mu.RLock()
+	for _, secret := range secrets.values {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	secrets.mu.RUnlock()
+	for _, p := range secretPatterns {
+		s = p.pattern.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+type redactedError struct {
+	err error
+}
+
+func (r *redactedError) Error() string {
+	return Redact(r.err.Error())
+}
+
+func (r *redactedError) Unwrap() error {
+	return r.err
+}
+
+// RedactError returns err with credentials removed from its message.  errors.Is and errors.As still see err.
+func RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*redactedError); ok {
+		return err
+	}
+	return &redactedError{err: err}
+}
+
+// RedactingCore wraps a zap core so credentials are removed from log messages and fields.  Use it with
+// zap.WrapCore(releaser.RedactingCore).
+func RedactingCore(core zapcore.Core) zapcore.Core {
+	return &redactingCore{Core: core}
+}
+
+type redactingCore struct {
+	zapcore.Core
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = Redact(entry.Message)
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	ret := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch f.Type {
+		case zapcore.StringType:
+			f.String = Redact(f.String)
+		case zapcore.ByteStringType:
+			if b, ok := f.Interface.([]byte); ok {
+				f.Interface = []byte(Redact(string(b)))
+			}
+		case zapcore.ErrorType:
+			if err, ok := f.Interface.(error); ok {
+				f = zap.NamedError(f.Key, RedactError(err))
+			}
+		}
+		ret[i] = f
+	}
+	return ret
+}
@@ -0,0 +1,244 @@
+package releaser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bradleyfalzon/ghinstallation"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultTokenRefreshBefore is how long before it expires a token is replaced, so that operations which start
+	// with the token, such as a slow push, finish before it stops working
+	defaultTokenRefreshBefore = 10 * time.Minute
+	// tokenRetryInterval is how long KeepFresh waits after a failed refresh
+	tokenRetryInterval = 30 * time.Second
+)
+
+// AccessToken is a code host token and when it stops working
+type AccessToken struct {
+	Value string
+	// ExpiresAt is zero for tokens that do not expire
+	ExpiresAt time.Time
+}
+
+// TokenHealth describes the token held by a TokenCache
+type TokenHealth struct {
+	// Healthy is true if there is a token that has not expired
+	Healthy     bool      `json:"healthy"`
+	ExpiresAt   time.Time `json:"expires_at,omitempty"`
+	RefreshedAt time.Time `json:"refreshed_at,omitempty"`
+	// Error is why the last refresh failed, if it did
+	Error string `json:"error,omitempty"`
+}
+
+// TokenCache holds the access token of a code host until shortly before it expires.  Tokens the host rejects can be
+// invalidated, so the next caller gets a new one.
+type TokenCache struct {
+	// RefreshBefore is how long before it expires a token is replaced
+	RefreshBefore time.Duration
+	Logger        *zap.Logger
+
+	fetch func(ctx context.Context) (*AccessToken, error)
+	// now is replaced by tests
+	now func() time.Time
+
+	mu          sync.Mutex
+	token       *AccessToken
+	refreshedAt time.Time
+	lastErr     error
+}
+
+// NewTokenCache creates a cache that gets tokens from fetch
+func NewTokenCache(logger *zap.Logger, fetch func(ctx context.Context) (*AccessToken, error)) *TokenCache {
+	return &TokenCache{
+		RefreshBefore: defaultTokenRefreshBefore,
+		Logger:        logger,
+		fetch:         fetch,
+		now:           time.Now,
+	}
+}
+
+// StaticTokenCache holds a token that never expires
+func StaticTokenCache(token string) *TokenCache {
+	AddSecret(token)
+	c := NewTokenCache(zap.NewNop(), func(_ context.Context) (*AccessToken, error) {
+		return &AccessToken{Value: token}, nil
+	})
+	c.token = &AccessToken{Value: token}
+	c.refreshedAt = c.now()
+	return c
+}
+
+// needsRefresh returns true if the cached token is missing or about to expire.  c.mu must be held.
+func (c *TokenCache) needsRefresh() bool {
+	if c.token == nil {
+		return true
+	}
+	return !c.token.ExpiresAt.IsZero() && !c.now().Before(c.token.ExpiresAt.Add(-c.RefreshBefore))
+}
+
+// Token returns the cached token, getting a new one first if it is about to expire
+func (c *TokenCache) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.needsRefresh() {
+		if err := c.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.token.Value, nil
+}
+
+// refresh replaces the cached token.  c.mu must be held.
+func (c *TokenCache) refresh(ctx context.Context) error {
+	token, err := c.fetch(ctx)
+	if err != nil {
+		c.lastErr = err
+		return fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	AddSecret(token.Value)
+	c.token = token
+	c.refreshedAt = c.now()
+	c.lastErr = nil
+	c.Logger.Debug("refreshed access token", zap.Time("expires_at", token.ExpiresAt))
+	return nil
+}
+
+// Invalidate drops token after the code host rejected it.  Tokens other than the cached one are ignored, so many
+// callers failing with the same token only cause one refresh.
+func (c *TokenCache) Invalidate(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != nil && c.token.Value == token {
+		c.Logger.Info("access token was rejected, it will be refreshed")
+		c.token = nil
+	}
+}
+
+// Health describes the cached token
+func (c *TokenCache) Health() TokenHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ret := TokenHealth{
+		RefreshedAt: c.refreshedAt,
+	}
+	if c.lastErr != nil {
+		ret.Error = Redact(c.lastErr.Error())
+	}
+	if c.token != nil {
+		ret.ExpiresAt = c.token.ExpiresAt
+		ret.Healthy = c.token.ExpiresAt.IsZero() || c.now().Before(c.token.ExpiresAt)
+	}
+	return ret
+}
+
+// KeepFresh refreshes the token before it expires until ctx ends, so callers never wait for a new token.  It returns
+// right away for tokens that do not expire.
+func (c *TokenCache) KeepFresh(ctx context.Context) {
+	for {
+		c.mu.Lock()
+		var wait time.Duration
+		if c.needsRefresh() {
+			if err := c.refresh(ctx); err != nil {
+				c.Logger.Warn("failed to refresh access token", zap.Error(err))
+				wait = tokenRetryInterval
+			}
+		}
+		if wait == 0 {
+			if c.token.ExpiresAt.IsZero() {
+				c.mu.Unlock()
+				return
+			}
+			wait = c.token.ExpiresAt.Add(-c.RefreshBefore).Sub(c.now())
+		}
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// installationTokenFetcher gets GitHub App installation tokens from the REST API at restURL
+func installationTokenFetcher(base http.RoundTripper, restURL string, appID int64, installID int64, pemLoc string) (func(ctx context.Context) (*AccessToken, error), error) {
+	apps, err := ghinstallation.NewAppsTransportKeyFromFile(base, appID, pemLoc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find key file: %w", err)
+	}
+	client := &http.Client{Transport: apps}
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", restURL, installID)
+	return func(ctx context.Context) (*AccessToken, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request installation token: %w", err)
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		if resp.StatusCode != http.StatusCreated {
+			b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+			return nil, fmt.Errorf("installation token request failed with %s: %s", resp.Status, string(b))
+		}
+		var ret struct {
+			Token     string    `json:"token"`
+			ExpiresAt time.Time `json:"expires_at"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+			return nil, fmt.Errorf("failed to decode installation token: %w", err)
+		}
+		return &AccessToken{Value: ret.Token, ExpiresAt: ret.ExpiresAt}, nil
+	}, nil
+}
+
+// tokenTransport authenticates requests with the token of a TokenCache.  A request the host rejects as unauthorized
+// is sent once more with a new token.
+type tokenTransport struct {
+	Base   http.RoundTripper
+	Tokens *TokenCache
+}
+
+func (t *tokenTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		token, err := t.Tokens.Token(request.Context())
+		if err != nil {
+			return nil, err
+		}
+		req := request.Clone(request.Context())
+		// Requests without a body, such as GETs, are sent again as they are
+		if attempt > 1 && request.Body != nil && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+		req.Header.Set("Authorization", "token "+token)
+		resp, err := t.Base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		canRetry := request.Body == nil || request.GetBody != nil
+		if resp.StatusCode != http.StatusUnauthorized || attempt > 1 || !canRetry {
+			return resp, nil
+		}
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+		t.Tokens.Invalidate(token)
+	}
+}
+
+var _ http.RoundTripper = &tokenTransport{}
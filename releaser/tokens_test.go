@@ -0,0 +1,120 @@
+package releaser
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTokenCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 11, 2, 16, 0, 0, 0, time.UTC)
+	fetches := 0
+	var fetchErr error
+	c := NewTokenCache(zap.NewNop(), func(_ context.Context) (*AccessToken, error) {
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		fetches++
+		return &AccessToken{Value: fmt.Sprintf("ghs_installation%dtokenvalue", fetches), ExpiresAt: now.Add(time.Hour)}, nil
+	})
+	c.now = func() time.Time { return now }
+	require.False(t, c.Health().Healthy)
+
+	token, err := c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ghs_installation1tokenvalue", token)
+	require.Equal(t, TokenHealth{Healthy: true, ExpiresAt: now.Add(time.Hour), RefreshedAt: now}, c.Health())
+
+	// The token is reused until it is about to expire
+	now = now.Add(45 * time.Minute)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ghs_installation1tokenvalue", token)
+	now = now.Add(5 * time.Minute)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ghs_installation2tokenvalue", token)
+
+	// Only the current token can be invalidated
+	c.Invalidate("ghs_installation1tokenvalue")
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ghs_installation2tokenvalue", token)
+	c.Invalidate(token)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ghs_installation3tokenvalue", token)
+
+	// A failed refresh keeps the token it has until it expires
+	fetchErr = fmt.Errorf("github is down")
+	now = now.Add(55 * time.Minute)
+	_, err = c.Token(ctx)
+	require.Error(t, err)
+	health := c.Health()
+	require.True(t, health.Healthy)
+	require.Equal(t, "github is down", health.Error)
+	now = now.Add(5 * time.Minute)
+	require.False(t, c.Health().Healthy)
+
+	static := StaticTokenCache("ghp_static")
+	require.True(t, static.Health().Healthy)
+	static.KeepFresh(ctx)
+}
+
+func TestTokenTransport(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, `{"query":"{viewer{login}}"}`, string(body))
+		}
+		seen = append(seen, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "token fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+	tokens := []string{"revoked", "fresh"}
+	c := NewTokenCache(zap.NewNop(), func(_ context.Context) (*AccessToken, error) {
+		ret := tokens[0]
+		if len(tokens) > 1 {
+			tokens = tokens[1:]
+		}
+		return &AccessToken{Value: ret, ExpiresAt: time.Now().Add(time.Hour)}, nil
+	})
+	client := &http.Client{Transport: &tokenTransport{Base: http.DefaultTransport, Tokens: c}}
+	post := func() int {
+		resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"query":"{viewer{login}}"}`))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusOK, post())
+	require.Equal(t, []string{"token revoked", "token fresh"}, seen)
+
+	// A request is only retried once
+	seen, tokens = nil, []string{"revoked"}
+	c.Invalidate("fresh")
+	require.Equal(t, http.StatusUnauthorized, post())
+	require.Equal(t, []string{"token revoked", "token revoked"}, seen)
+
+	// Requests without a body are retried too
+	seen, tokens = nil, []string{"fresh"}
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{"token revoked", "token fresh"}, seen)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -38,10 +39,16 @@ type ReleaseCandidate struct {
 	ExistingPR  int64                  `json:"existing_pr"`
 	OriginalSHA string                 `json:"original_sha"`
 	Age         time.Duration          `json:"age"`
+	// PullRequest describes ExistingPR, if there is one
+	PullRequest *PullRequestDetails `json:"pull_request,omitempty"`
 }
 
 func (r *ReleaseCandidate) MarshalText() (text []byte, err error) {
-	return []byte(fmt.Sprintf("%s %s %d %s %s", r.Name, r.Status, r.ExistingPR, r.OriginalSHA, r.Age)), nil
+	ret := fmt.Sprintf("%s %s %d %s %s", r.Name, r.Status, r.ExistingPR, r.OriginalSHA, r.Age)
+	if r.PullRequest != nil {
+		ret += " " + r.PullRequest.String()
+	}
+	return []byte(ret), nil
 }
 
 type Application struct {
@@ -137,61 +144,122 @@ func GetAllReleaseStatus(ctx context.Context, a Api) (*ApplicationList, error) {
 		return nil, fmt.Errorf("failed to get application list: %w", err)
 	}
 	var ret ApplicationList
-	eg, egCtx := errgroup.WithContext(ctx)
-	for _, app := range apps {
-		releases, err := a.ListReleases(app)
+	for _, name := range apps {
+		app, err := ApplicationReleaseStatus(ctx, a, name)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get release list for %s: %w", app, err)
-		}
-		app := Application{
-			Name: app,
-		}
-		for idx, release := range releases {
-			if idx == 0 {
-				app.ReleaseCandidate = append(app.ReleaseCandidate, &ReleaseCandidate{
-					Name:   release,
-					Status: RC_STATUS_RELEASED,
-				})
-				continue
-			}
-			hasChange, err := NeedsPromotion(egCtx, a, app.Name, release)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get preview for %s:%s: %w", app.Name, release, err)
-			}
-			existingRelease, err := a.GetRelease(app.Name, release)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get preview for %s:%s: %w", app.Name, release, err)
-			}
-			releaseConfig, err := existingRelease.loadReleaseConfig()
-			if err != nil {
-				return nil, fmt.Errorf("failed to load release config for %s:%s: %w", app.Name, release, err)
-			}
-			rc := &ReleaseCandidate{
-				Name:        release,
-				Status:      getStatus(hasChange),
-				OriginalSHA: releaseConfig.Metadata.OriginalRelease.GitSha,
-			}
+			return nil, err
+		}
+		ret.Application = append(ret.Application, *app)
+	}
+	if err := AddPullRequestStatus(ctx, a, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// ApplicationReleaseStatus returns whether each release of an application needs a promotion.  PRs are left out, see
+// AddPullRequestStatus.
+func ApplicationReleaseStatus(ctx context.Context, a Api, application string) (*Application, error) {
+	releases, err := a.ListReleases(application)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release list for %s: %w", application, err)
+	}
+	app := &Application{
+		Name: application,
+	}
+	for idx, release := range releases {
+		if idx == 0 {
+			app.ReleaseCandidate = append(app.ReleaseCandidate, &ReleaseCandidate{
+				Name:   release,
+				Status: RC_STATUS_RELEASED,
+			})
+			continue
+		}
+		hasChange, err := NeedsPromotion(ctx, a, app.Name, release)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get preview for %s:%s: %w", app.Name, release, err)
+		}
+		existingRelease, err := a.GetRelease(app.Name, release)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get preview for %s:%s: %w", app.Name, release, err)
+		}
+		releaseConfig, err := existingRelease.loadReleaseConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load release config for %s:%s: %w", app.Name, release, err)
+		}
+		app.ReleaseCandidate = append(app.ReleaseCandidate, &ReleaseCandidate{
+			Name:        release,
+			Status:      getStatus(hasChange),
+			OriginalSHA: releaseConfig.Metadata.OriginalRelease.GitSha,
+		})
+	}
+	return app, nil
+}
+
+// AddPullRequestStatus sets the PR, and its details, of every pending release in list
+func AddPullRequestStatus(ctx context.Context, a Api, list *ApplicationList) error {
+	type pendingRelease struct {
+		branch string
+		rc     *ReleaseCandidate
+	}
+	var pending []pendingRelease
+	for _, app := range list.Application {
+		for _, rc := range app.ReleaseCandidate {
 			if rc.Status == RC_STATUS_PENDING {
-				a := a
-				release := release
-				rc := rc
-				eg.Go(func() error {
-					prNum, err := CheckForPRForRelease(egCtx, a, app.Name, release)
-					if err != nil {
-						return fmt.Errorf("failed to check for PR for %s:%s: %w", app.Name, release, err)
-					}
-					rc.ExistingPR = prNum
-					return nil
-				})
+				pending = append(pending, pendingRelease{branch: DefaultBranchNameForRelease(app.Name, rc.Name), rc: rc})
 			}
-			app.ReleaseCandidate = append(app.ReleaseCandidate, rc)
 		}
-		ret.Application = append(ret.Application, app)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	// One listing of every releaser PR is far cheaper than a query per pending release
+	prs, err := a.ReleasePullRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list release PRs: %w", err)
+	}
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, p := range pending {
+		pr, exists := prs[p.branch]
+		if !exists {
+			continue
+		}
+		p.rc.ExistingPR = pr.Number
+		p.rc.PullRequest = pr.Details
+		if pr.Details != nil {
+			continue
+		}
+		rc := p.rc
+		eg.Go(func() error {
+			details, err := a.PullRequestDetails(egCtx, rc.ExistingPR)
+			if err != nil {
+				return fmt.Errorf("failed to get details of PR %d: %w", rc.ExistingPR, err)
+			}
+			rc.PullRequest = details
+			return nil
+		})
 	}
 	if err := eg.Wait(); err != nil {
-		return nil, fmt.Errorf("failed to wait for all PRs: %w", err)
+		return fmt.Errorf("failed to wait for all PRs: %w", err)
 	}
-	return &ret, nil
+	return nil
+}
+
+// ChangedApplications returns the applications whose directories contain any of files, which are relative to the root
+// of the repository.  all is true if files include configuration every application reads, such as apps/.releaser.yaml.
+func ChangedApplications(files []string) (apps map[string]bool, all bool) {
+	apps = make(map[string]bool)
+	for _, f := range files {
+		parts := strings.SplitN(filepath.ToSlash(f), "/", 3)
+		if parts[0] != "apps" || len(parts) == 1 {
+			continue
+		}
+		if len(parts) == 2 {
+			return nil, true
+		}
+		apps[parts[1]] = true
+	}
+	return apps, false
 }
 
 func getStatus(change bool) ReleaseCandidateStatus {
@@ -200,3 +268,165 @@ func getStatus(change bool) ReleaseCandidateStatus {
 	}
 	return RC_STATUS_RELEASED
 }
+
+type PromoteStatus int
+
+const (
+	PROMOTE_STATUS_UNKNOWN PromoteStatus = iota
+	PROMOTE_STATUS_NO_CHANGES
+	PROMOTE_STATUS_EXISTING_PULL_REQUEST
+	PROMOTE_STATUS_NEW_PULL_REQUEST
+	PROMOTE_STATUS_DIRECT_COMMIT
+	PROMOTE_STATUS_UPDATED_PULL_REQUEST
+)
+
+func (p PromoteStatus) String() string {
+	switch p {
+	case PROMOTE_STATUS_NO_CHANGES:
+		return "no_changes"
+	case PROMOTE_STATUS_EXISTING_PULL_REQUEST:
+		return "existing_pull_request"
+	case PROMOTE_STATUS_NEW_PULL_REQUEST:
+		return "new_pull_request"
+	case PROMOTE_STATUS_DIRECT_COMMIT:
+		return "direct_commit"
+	case PROMOTE_STATUS_UPDATED_PULL_REQUEST:
+		return "updated_pull_request"
+	default:
+		return "unknown"
+	}
+}
+
+type PromoteResult struct {
+	Status PromoteStatus `json:"status"`
+	// PullRequest is the PR of the promotion, in pull request mode
+	PullRequest int64 `json:"pull_request,omitempty"`
+	// CommitSha is the commit pushed to the default branch, in direct mode
+	CommitSha string `json:"commit_sha,omitempty"`
+	// AutoMerge is the merge method auto-merge was enabled with on a new PR
+	AutoMerge MergeMethod `json:"auto_merge,omitempty"`
+}
+
+func (p *PromoteResult) MarshalText() (text []byte, err error) {
+	switch p.Status {
+	case PROMOTE_STATUS_EXISTING_PULL_REQUEST, PROMOTE_STATUS_UPDATED_PULL_REQUEST:
+		return []byte(fmt.Sprintf("%s %d", p.Status, p.PullRequest)), nil
+	case PROMOTE_STATUS_NEW_PULL_REQUEST:
+		if p.AutoMerge != "" {
+			return []byte(fmt.Sprintf("%s %d auto_merge=%s", p.Status, p.PullRequest, p.AutoMerge)), nil
+		}
+		return []byte(fmt.Sprintf("%s %d", p.Status, p.PullRequest)), nil
+	case PROMOTE_STATUS_DIRECT_COMMIT:
+		return []byte(fmt.Sprintf("%s %s", p.Status, p.CommitSha)), nil
+	default:
+		return []byte(p.Status.String()), nil
+	}
+}
+
+// PromoteStep is a stage of Promote
+type PromoteStep string
+
+const (
+	PromoteStepBranch      PromoteStep = "branch"
+	PromoteStepApply       PromoteStep = "apply"
+	PromoteStepCommit      PromoteStep = "commit"
+	PromoteStepPush        PromoteStep = "push"
+	PromoteStepPullRequest PromoteStep = "pull_request"
+)
+
+type promoteProgressKey struct{}
+
+// WithPromoteProgress returns a context that makes Promote call progress as it starts each step
+func WithPromoteProgress(ctx context.Context, progress func(step PromoteStep)) context.Context {
+	return context.WithValue(ctx, promoteProgressKey{}, progress)
+}
+
+func reportPromoteStep(ctx context.Context, step PromoteStep) {
+	if progress, ok := ctx.Value(promoteProgressKey{}).(func(step PromoteStep)); ok {
+		progress(step)
+	}
+}
+
+// Promote promotes a release from a clean checkout, the way the release's mode asks for.  In pull request mode, the
+// promotion is committed to a fresh branch from the default branch and pushed.  A PR is opened, or if one already
+// exists and the rebuilt release differs from its branch, the PR is updated.  In direct mode, the promotion is committed
+// onto the default branch.  New PRs get auto-merge enabled if the release asks for it.
+func Promote(ctx context.Context, a Api, application string, release string) (*PromoteResult, error) {
+	mode, err := a.ReleaseMode(application, release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release mode: %w", err)
+	}
+	if mode == ReleaseModeDirect {
+		reportPromoteStep(ctx, PromoteStepCommit)
+		sha, err := a.CommitDirect(ctx, application, release)
+		if err != nil {
+			return nil, fmt.Errorf("failed to commit release directly: %w", err)
+		}
+		if sha == "" {
+			return &PromoteResult{Status: PROMOTE_STATUS_NO_CHANGES}, nil
+		}
+		return &PromoteResult{Status: PROMOTE_STATUS_DIRECT_COMMIT, CommitSha: sha}, nil
+	}
+	autoMerge, err := a.ReleaseAutoMerge(application, release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release auto-merge: %w", err)
+	}
+	branchName := DefaultBranchNameForRelease(application, release)
+	existingPR, err := a.CheckForPRForBranch(ctx, branchName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for existing PR for branch %s: %w", branchName, err)
+	}
+	reportPromoteStep(ctx, PromoteStepBranch)
+	if err := a.FreshGitBranch(ctx, application, release, ""); err != nil {
+		return nil, fmt.Errorf("failed to create branch %s: %w", branchName, err)
+	}
+	reportPromoteStep(ctx, PromoteStepApply)
+	oldRelease, newRelease, err := a.PreviewRelease(ctx, application, release, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview release: %w", err)
+	}
+	if err := a.ApplyRelease(application, release, oldRelease, newRelease); err != nil {
+		return nil, fmt.Errorf("failed to apply release: %w", err)
+	}
+	if changes, err := a.AreThereUncommittedChanges(ctx); err != nil {
+		return nil, fmt.Errorf("failed to check for uncommitted changes: %w", err)
+	} else if !changes {
+		if existingPR != 0 {
+			return &PromoteResult{Status: PROMOTE_STATUS_EXISTING_PULL_REQUEST, PullRequest: existingPR}, nil
+		}
+		return &PromoteResult{Status: PROMOTE_STATUS_NO_CHANGES}, nil
+	}
+	if existingPR != 0 {
+		// Only touch the existing PR if the rebuilt release differs, so reviews of up to date content stay valid
+		if differs, err := a.ReleaseBranchDiffers(ctx, application, release); err != nil {
+			return nil, fmt.Errorf("failed to compare with branch %s: %w", branchName, err)
+		} else if !differs {
+			return &PromoteResult{Status: PROMOTE_STATUS_EXISTING_PULL_REQUEST, PullRequest: existingPR}, nil
+		}
+	}
+	reportPromoteStep(ctx, PromoteStepCommit)
+	if err := a.CommitForRelease(ctx, application, release); err != nil {
+		return nil, fmt.Errorf("failed to commit release: %w", err)
+	}
+	reportPromoteStep(ctx, PromoteStepPush)
+	if err := a.ForcePushCurrentBranch(ctx); err != nil {
+		return nil, fmt.Errorf("failed to push release: %w", err)
+	}
+	reportPromoteStep(ctx, PromoteStepPullRequest)
+	if existingPR != 0 {
+		if err := a.UpdatePullRequestCurrent(ctx, existingPR); err != nil {
+			return nil, fmt.Errorf("failed to update PR %d: %w", existingPR, err)
+		}
+		return &PromoteResult{Status: PROMOTE_STATUS_UPDATED_PULL_REQUEST, PullRequest: existingPR}, nil
+	}
+	prNum, err := a.PullRequestCurrent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	if autoMerge != "" {
+		if err := a.EnableAutoMergeForCurrentRemote(ctx, prNum, autoMerge); err != nil {
+			return nil, fmt.Errorf("failed to enable auto-merge on PR %d: %w", prNum, err)
+		}
+	}
+	return &PromoteResult{Status: PROMOTE_STATUS_NEW_PULL_REQUEST, PullRequest: prNum, AutoMerge: autoMerge}, nil
+}
@@ -20,21 +20,36 @@ func (z *Zaptransport) RoundTrip(request *http.Request) (*http.Response, error)
 		return z.Base.RoundTrip(request)
 	}
 	var bodyReadAsBuffer bytes.Buffer
-	if _, err := io.Copy(&bodyReadAsBuffer, request.Body); err != nil {
-		return nil, fmt.Errorf("error reading request body: %w", err)
+	if request.Body != nil {
+		if _, err := io.Copy(&bodyReadAsBuffer, request.Body); err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		request.Body = ioutil.NopCloser(&bodyReadAsBuffer)
 	}
-	request.Body = ioutil.NopCloser(&bodyReadAsBuffer)
-	z.Logger.Debug("staring request", zap.String("url", request.URL.String()), zap.String("method", request.Method), zap.Any("header", request.Header), zap.Any("body", bodyReadAsBuffer.String()))
-	defer z.Logger.Debug("ending request", zap.String("url", request.URL.String()))
+	z.Logger.Debug("staring request", zap.String("url", Redact(request.URL.String())), zap.String("method", request.Method), zap.Any("header", redactHeader(request.Header)), zap.String("body", Redact(bodyReadAsBuffer.String())))
+	defer z.Logger.Debug("ending request", zap.String("url", Redact(request.URL.String())))
 	resp, err := z.Base.RoundTrip(request)
 	if err != nil {
 		z.Logger.Debug("response error", zap.Error(err))
 	} else {
-		z.Logger.Debug("response", zap.Any("header", resp.Header), zap.Any("body", resp.Body))
+		z.Logger.Debug("response", zap.Any("header", redactHeader(resp.Header)), zap.Any("body", resp.Body))
 	}
 	return resp, err
 }
 
+// sensitiveHeaders carry credentials, so their values are never logged
+var sensitiveHeaders = []string{"Authorization", "Private-Token", "Cookie", "Set-Cookie"}
+
+func redactHeader(h http.Header) http.Header {
+	ret := h.Clone()
+	for _, name := range sensitiveHeaders {
+		if ret.Get(name) != "" {
+			ret.Set(name, redacted)
+		}
+	}
+	return ret
+}
+
 func DebugLogTransport(base http.RoundTripper, logger *zap.Logger) http.RoundTripper {
 	if logger.Core().Enabled(zap.DebugLevel) {
 		return &Zaptransport{
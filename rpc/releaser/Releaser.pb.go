@@ -21,6 +21,228 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Job_State int32
+
+const (
+	Job_STATE_UNKNOWN Job_State = 0
+	// The job is waiting for other promotions of the application, or for a free worker
+	Job_STATE_QUEUED    Job_State = 1
+	Job_STATE_RUNNING   Job_State = 2
+	Job_STATE_SUCCEEDED Job_State = 3
+	Job_STATE_FAILED    Job_State = 4
+)
+
+// Enum value maps for Job_State.
+var (
+	Job_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_QUEUED",
+		2: "STATE_RUNNING",
+		3: "STATE_SUCCEEDED",
+		4: "STATE_FAILED",
+	}
+	Job_State_value = map[string]int32{
+		"STATE_UNKNOWN":   0,
+		"STATE_QUEUED":    1,
+		"STATE_RUNNING":   2,
+		"STATE_SUCCEEDED": 3,
+		"STATE_FAILED":    4,
+	}
+)
+
+func (x Job_State) Enum() *Job_State {
+	p := new(Job_State)
+	*p = x
+	return p
+}
+
+func (x Job_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[0].Descriptor()
+}
+
+func (Job_State) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[0]
+}
+
+func (x Job_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_State.Descriptor instead.
+func (Job_State) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type JobStep_State int32
+
+const (
+	JobStep_STATE_UNKNOWN JobStep_State = 0
+	JobStep_STATE_RUNNING JobStep_State = 1
+	JobStep_STATE_DONE    JobStep_State = 2
+	JobStep_STATE_FAILED  JobStep_State = 3
+)
+
+// Enum value maps for JobStep_State.
+var (
+	JobStep_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_RUNNING",
+		2: "STATE_DONE",
+		3: "STATE_FAILED",
+	}
+	JobStep_State_value = map[string]int32{
+		"STATE_UNKNOWN": 0,
+		"STATE_RUNNING": 1,
+		"STATE_DONE":    2,
+		"STATE_FAILED":  3,
+	}
+)
+
+func (x JobStep_State) Enum() *JobStep_State {
+	p := new(JobStep_State)
+	*p = x
+	return p
+}
+
+func (x JobStep_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStep_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[1].Descriptor()
+}
+
+func (JobStep_State) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[1]
+}
+
+func (x JobStep_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStep_State.Descriptor instead.
+func (JobStep_State) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type Deployment_State int32
+
+const (
+	// The deployment has no status yet
+	Deployment_STATE_UNKNOWN     Deployment_State = 0
+	Deployment_STATE_QUEUED      Deployment_State = 1
+	Deployment_STATE_PENDING     Deployment_State = 2
+	Deployment_STATE_IN_PROGRESS Deployment_State = 3
+	Deployment_STATE_SUCCESS     Deployment_State = 4
+	Deployment_STATE_FAILURE     Deployment_State = 5
+	Deployment_STATE_ERROR       Deployment_State = 6
+	Deployment_STATE_INACTIVE    Deployment_State = 7
+)
+
+// Enum value maps for Deployment_State.
+var (
+	Deployment_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_QUEUED",
+		2: "STATE_PENDING",
+		3: "STATE_IN_PROGRESS",
+		4: "STATE_SUCCESS",
+		5: "STATE_FAILURE",
+		6: "STATE_ERROR",
+		7: "STATE_INACTIVE",
+	}
+	Deployment_State_value = map[string]int32{
+		"STATE_UNKNOWN":     0,
+		"STATE_QUEUED":      1,
+		"STATE_PENDING":     2,
+		"STATE_IN_PROGRESS": 3,
+		"STATE_SUCCESS":     4,
+		"STATE_FAILURE":     5,
+		"STATE_ERROR":       6,
+		"STATE_INACTIVE":    7,
+	}
+)
+
+func (x Deployment_State) Enum() *Deployment_State {
+	p := new(Deployment_State)
+	*p = x
+	return p
+}
+
+func (x Deployment_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Deployment_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[2].Descriptor()
+}
+
+func (Deployment_State) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[2]
+}
+
+func (x Deployment_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Deployment_State.Descriptor instead.
+func (Deployment_State) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type EnableAutoMergeRequest_MergeMethod int32
+
+const (
+	EnableAutoMergeRequest_SQUASH EnableAutoMergeRequest_MergeMethod = 0
+	EnableAutoMergeRequest_MERGE  EnableAutoMergeRequest_MergeMethod = 1
+	EnableAutoMergeRequest_REBASE EnableAutoMergeRequest_MergeMethod = 2
+)
+
+// Enum value maps for EnableAutoMergeRequest_MergeMethod.
+var (
+	EnableAutoMergeRequest_MergeMethod_name = map[int32]string{
+		0: "SQUASH",
+		1: "MERGE",
+		2: "REBASE",
+	}
+	EnableAutoMergeRequest_MergeMethod_value = map[string]int32{
+		"SQUASH": 0,
+		"MERGE":  1,
+		"REBASE": 2,
+	}
+)
+
+func (x EnableAutoMergeRequest_MergeMethod) Enum() *EnableAutoMergeRequest_MergeMethod {
+	p := new(EnableAutoMergeRequest_MergeMethod)
+	*p = x
+	return p
+}
+
+func (x EnableAutoMergeRequest_MergeMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnableAutoMergeRequest_MergeMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[3].Descriptor()
+}
+
+func (EnableAutoMergeRequest_MergeMethod) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[3]
+}
+
+func (x EnableAutoMergeRequest_MergeMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnableAutoMergeRequest_MergeMethod.Descriptor instead.
+func (EnableAutoMergeRequest_MergeMethod) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{31, 0}
+}
+
 type PushPromotionResponse_Status int32
 
 const (
@@ -28,6 +250,10 @@ const (
 	PushPromotionResponse_EXISTING_PULL_REQUEST PushPromotionResponse_Status = 1
 	PushPromotionResponse_NEW_PULL_REQUEST      PushPromotionResponse_Status = 2
 	PushPromotionResponse_NO_CHANGES            PushPromotionResponse_Status = 3
+	// The release is in direct mode, and the promotion was committed onto the default branch
+	PushPromotionResponse_DIRECT_COMMIT PushPromotionResponse_Status = 4
+	// A pull request existed, and its branch was rebuilt because the release changed since it was opened
+	PushPromotionResponse_UPDATED_PULL_REQUEST PushPromotionResponse_Status = 5
 )
 
 // Enum value maps for PushPromotionResponse_Status.
@@ -37,12 +263,16 @@ var (
 		1: "EXISTING_PULL_REQUEST",
 		2: "NEW_PULL_REQUEST",
 		3: "NO_CHANGES",
+		4: "DIRECT_COMMIT",
+		5: "UPDATED_PULL_REQUEST",
 	}
 	PushPromotionResponse_Status_value = map[string]int32{
 		"UNKNOWN":               0,
 		"EXISTING_PULL_REQUEST": 1,
 		"NEW_PULL_REQUEST":      2,
 		"NO_CHANGES":            3,
+		"DIRECT_COMMIT":         4,
+		"UPDATED_PULL_REQUEST":  5,
 	}
 )
 
@@ -57,11 +287,11 @@ func (x PushPromotionResponse_Status) String() string {
 }
 
 func (PushPromotionResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_releaser_Releaser_proto_enumTypes[0].Descriptor()
+	return file_rpc_releaser_Releaser_proto_enumTypes[4].Descriptor()
 }
 
 func (PushPromotionResponse_Status) Type() protoreflect.EnumType {
-	return &file_rpc_releaser_Releaser_proto_enumTypes[0]
+	return &file_rpc_releaser_Releaser_proto_enumTypes[4]
 }
 
 func (x PushPromotionResponse_Status) Number() protoreflect.EnumNumber {
@@ -70,7 +300,7 @@ func (x PushPromotionResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PushPromotionResponse_Status.Descriptor instead.
 func (PushPromotionResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{3, 0}
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{36, 0}
 }
 
 type ReleaseStatus_Status int32
@@ -106,11 +336,11 @@ func (x ReleaseStatus_Status) String() string {
 }
 
 func (ReleaseStatus_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_releaser_Releaser_proto_enumTypes[1].Descriptor()
+	return file_rpc_releaser_Releaser_proto_enumTypes[5].Descriptor()
 }
 
 func (ReleaseStatus_Status) Type() protoreflect.EnumType {
-	return &file_rpc_releaser_Releaser_proto_enumTypes[1]
+	return &file_rpc_releaser_Releaser_proto_enumTypes[5]
 }
 
 func (x ReleaseStatus_Status) Number() protoreflect.EnumNumber {
@@ -119,17 +349,308 @@ func (x ReleaseStatus_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReleaseStatus_Status.Descriptor instead.
 func (ReleaseStatus_Status) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{7, 0}
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{41, 0}
 }
 
-type RefreshRepositoryRequest struct {
+type PullRequestStatus_State int32
+
+const (
+	PullRequestStatus_STATE_UNKNOWN PullRequestStatus_State = 0
+	PullRequestStatus_STATE_OPEN    PullRequestStatus_State = 1
+	PullRequestStatus_STATE_MERGED  PullRequestStatus_State = 2
+	PullRequestStatus_STATE_CLOSED  PullRequestStatus_State = 3
+)
+
+// Enum value maps for PullRequestStatus_State.
+var (
+	PullRequestStatus_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_OPEN",
+		2: "STATE_MERGED",
+		3: "STATE_CLOSED",
+	}
+	PullRequestStatus_State_value = map[string]int32{
+		"STATE_UNKNOWN": 0,
+		"STATE_OPEN":    1,
+		"STATE_MERGED":  2,
+		"STATE_CLOSED":  3,
+	}
+)
+
+func (x PullRequestStatus_State) Enum() *PullRequestStatus_State {
+	p := new(PullRequestStatus_State)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[6].Descriptor()
+}
+
+func (PullRequestStatus_State) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[6]
+}
+
+func (x PullRequestStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus_State.Descriptor instead.
+func (PullRequestStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{42, 0}
+}
+
+type PullRequestStatus_ReviewDecision int32
+
+const (
+	// The PR does not need a review
+	PullRequestStatus_REVIEW_DECISION_NONE              PullRequestStatus_ReviewDecision = 0
+	PullRequestStatus_REVIEW_DECISION_APPROVED          PullRequestStatus_ReviewDecision = 1
+	PullRequestStatus_REVIEW_DECISION_CHANGES_REQUESTED PullRequestStatus_ReviewDecision = 2
+	PullRequestStatus_REVIEW_DECISION_REVIEW_REQUIRED   PullRequestStatus_ReviewDecision = 3
+)
+
+// Enum value maps for PullRequestStatus_ReviewDecision.
+var (
+	PullRequestStatus_ReviewDecision_name = map[int32]string{
+		0: "REVIEW_DECISION_NONE",
+		1: "REVIEW_DECISION_APPROVED",
+		2: "REVIEW_DECISION_CHANGES_REQUESTED",
+		3: "REVIEW_DECISION_REVIEW_REQUIRED",
+	}
+	PullRequestStatus_ReviewDecision_value = map[string]int32{
+		"REVIEW_DECISION_NONE":              0,
+		"REVIEW_DECISION_APPROVED":          1,
+		"REVIEW_DECISION_CHANGES_REQUESTED": 2,
+		"REVIEW_DECISION_REVIEW_REQUIRED":   3,
+	}
+)
+
+func (x PullRequestStatus_ReviewDecision) Enum() *PullRequestStatus_ReviewDecision {
+	p := new(PullRequestStatus_ReviewDecision)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus_ReviewDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus_ReviewDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[7].Descriptor()
+}
+
+func (PullRequestStatus_ReviewDecision) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[7]
+}
+
+func (x PullRequestStatus_ReviewDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus_ReviewDecision.Descriptor instead.
+func (PullRequestStatus_ReviewDecision) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{42, 1}
+}
+
+type PullRequestStatus_Mergeable int32
+
+const (
+	// The code host has not finished checking for conflicts
+	PullRequestStatus_MERGEABLE_UNKNOWN     PullRequestStatus_Mergeable = 0
+	PullRequestStatus_MERGEABLE_MERGEABLE   PullRequestStatus_Mergeable = 1
+	PullRequestStatus_MERGEABLE_CONFLICTING PullRequestStatus_Mergeable = 2
+)
+
+// Enum value maps for PullRequestStatus_Mergeable.
+var (
+	PullRequestStatus_Mergeable_name = map[int32]string{
+		0: "MERGEABLE_UNKNOWN",
+		1: "MERGEABLE_MERGEABLE",
+		2: "MERGEABLE_CONFLICTING",
+	}
+	PullRequestStatus_Mergeable_value = map[string]int32{
+		"MERGEABLE_UNKNOWN":     0,
+		"MERGEABLE_MERGEABLE":   1,
+		"MERGEABLE_CONFLICTING": 2,
+	}
+)
+
+func (x PullRequestStatus_Mergeable) Enum() *PullRequestStatus_Mergeable {
+	p := new(PullRequestStatus_Mergeable)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus_Mergeable) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus_Mergeable) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[8].Descriptor()
+}
+
+func (PullRequestStatus_Mergeable) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[8]
+}
+
+func (x PullRequestStatus_Mergeable) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus_Mergeable.Descriptor instead.
+func (PullRequestStatus_Mergeable) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{42, 2}
+}
+
+type PullRequestStatus_Checks int32
+
+const (
+	// The PR has no status checks
+	PullRequestStatus_CHECKS_NONE    PullRequestStatus_Checks = 0
+	PullRequestStatus_CHECKS_PENDING PullRequestStatus_Checks = 1
+	PullRequestStatus_CHECKS_SUCCESS PullRequestStatus_Checks = 2
+	PullRequestStatus_CHECKS_FAILURE PullRequestStatus_Checks = 3
+)
+
+// Enum value maps for PullRequestStatus_Checks.
+var (
+	PullRequestStatus_Checks_name = map[int32]string{
+		0: "CHECKS_NONE",
+		1: "CHECKS_PENDING",
+		2: "CHECKS_SUCCESS",
+		3: "CHECKS_FAILURE",
+	}
+	PullRequestStatus_Checks_value = map[string]int32{
+		"CHECKS_NONE":    0,
+		"CHECKS_PENDING": 1,
+		"CHECKS_SUCCESS": 2,
+		"CHECKS_FAILURE": 3,
+	}
+)
+
+func (x PullRequestStatus_Checks) Enum() *PullRequestStatus_Checks {
+	p := new(PullRequestStatus_Checks)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus_Checks) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus_Checks) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[9].Descriptor()
+}
+
+func (PullRequestStatus_Checks) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[9]
+}
+
+func (x PullRequestStatus_Checks) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus_Checks.Descriptor instead.
+func (PullRequestStatus_Checks) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{42, 3}
+}
+
+type StatusEvent_Type int32
+
+const (
+	StatusEvent_TYPE_UNKNOWN StatusEvent_Type = 0
+	// Events were missed, or the stream just started.  Fetch the full status with GetAllApplicationStatus.
+	StatusEvent_TYPE_RESET           StatusEvent_Type = 1
+	StatusEvent_TYPE_RELEASE_ADDED   StatusEvent_Type = 2
+	StatusEvent_TYPE_RELEASE_REMOVED StatusEvent_Type = 3
+	// The release needs a promotion
+	StatusEvent_TYPE_RELEASE_PENDING StatusEvent_Type = 4
+	// The release no longer needs a promotion
+	StatusEvent_TYPE_RELEASE_RELEASED    StatusEvent_Type = 5
+	StatusEvent_TYPE_PULL_REQUEST_OPENED StatusEvent_Type = 6
+	// The reviews, mergeability or checks of the pull request changed
+	StatusEvent_TYPE_PULL_REQUEST_UPDATED StatusEvent_Type = 7
+	StatusEvent_TYPE_PULL_REQUEST_MERGED  StatusEvent_Type = 8
+	StatusEvent_TYPE_PULL_REQUEST_CLOSED  StatusEvent_Type = 9
+	StatusEvent_TYPE_HOLD_ADDED           StatusEvent_Type = 10
+	StatusEvent_TYPE_HOLD_REMOVED         StatusEvent_Type = 11
+)
+
+// Enum value maps for StatusEvent_Type.
+var (
+	StatusEvent_Type_name = map[int32]string{
+		0:  "TYPE_UNKNOWN",
+		1:  "TYPE_RESET",
+		2:  "TYPE_RELEASE_ADDED",
+		3:  "TYPE_RELEASE_REMOVED",
+		4:  "TYPE_RELEASE_PENDING",
+		5:  "TYPE_RELEASE_RELEASED",
+		6:  "TYPE_PULL_REQUEST_OPENED",
+		7:  "TYPE_PULL_REQUEST_UPDATED",
+		8:  "TYPE_PULL_REQUEST_MERGED",
+		9:  "TYPE_PULL_REQUEST_CLOSED",
+		10: "TYPE_HOLD_ADDED",
+		11: "TYPE_HOLD_REMOVED",
+	}
+	StatusEvent_Type_value = map[string]int32{
+		"TYPE_UNKNOWN":              0,
+		"TYPE_RESET":                1,
+		"TYPE_RELEASE_ADDED":        2,
+		"TYPE_RELEASE_REMOVED":      3,
+		"TYPE_RELEASE_PENDING":      4,
+		"TYPE_RELEASE_RELEASED":     5,
+		"TYPE_PULL_REQUEST_OPENED":  6,
+		"TYPE_PULL_REQUEST_UPDATED": 7,
+		"TYPE_PULL_REQUEST_MERGED":  8,
+		"TYPE_PULL_REQUEST_CLOSED":  9,
+		"TYPE_HOLD_ADDED":           10,
+		"TYPE_HOLD_REMOVED":         11,
+	}
+)
+
+func (x StatusEvent_Type) Enum() *StatusEvent_Type {
+	p := new(StatusEvent_Type)
+	*p = x
+	return p
+}
+
+func (x StatusEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_releaser_Releaser_proto_enumTypes[10].Descriptor()
+}
+
+func (StatusEvent_Type) Type() protoreflect.EnumType {
+	return &file_rpc_releaser_Releaser_proto_enumTypes[10]
+}
+
+func (x StatusEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEvent_Type.Descriptor instead.
+func (StatusEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type ListApplicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Repository to list.  May be empty if the server only manages one repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
 }
 
-func (x *RefreshRepositoryRequest) Reset() {
-	*x = RefreshRepositoryRequest{}
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -137,13 +658,13 @@ func (x *RefreshRepositoryRequest) Reset() {
 	}
 }
 
-func (x *RefreshRepositoryRequest) String() string {
+func (x *ListApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshRepositoryRequest) ProtoMessage() {}
+func (*ListApplicationsRequest) ProtoMessage() {}
 
-func (x *RefreshRepositoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -155,19 +676,30 @@ func (x *RefreshRepositoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshRepositoryRequest.ProtoReflect.Descriptor instead.
-func (*RefreshRepositoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{0}
 }
 
-type RefreshRepositoryResponse struct {
+func (x *ListApplicationsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type ListApplicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ApplicationNames []string `protobuf:"bytes,1,rep,name=application_names,json=applicationNames,proto3" json:"application_names,omitempty"`
+	// SHA of the default branch the applications were read at
+	GitSha string `protobuf:"bytes,2,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
 }
 
-func (x *RefreshRepositoryResponse) Reset() {
-	*x = RefreshRepositoryResponse{}
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,13 +707,13 @@ func (x *RefreshRepositoryResponse) Reset() {
 	}
 }
 
-func (x *RefreshRepositoryResponse) String() string {
+func (x *ListApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshRepositoryResponse) ProtoMessage() {}
+func (*ListApplicationsResponse) ProtoMessage() {}
 
-func (x *RefreshRepositoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -193,22 +725,37 @@ func (x *RefreshRepositoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshRepositoryResponse.ProtoReflect.Descriptor instead.
-func (*RefreshRepositoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{1}
 }
 
-type PushPromotionRequest struct {
+func (x *ListApplicationsResponse) GetApplicationNames() []string {
+	if x != nil {
+		return x.ApplicationNames
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
+type ListReleasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApplicationName string `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
-	ReleaseName     string `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
 }
 
-func (x *PushPromotionRequest) Reset() {
-	*x = PushPromotionRequest{}
+func (x *ListReleasesRequest) Reset() {
+	*x = ListReleasesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -216,13 +763,13 @@ func (x *PushPromotionRequest) Reset() {
 	}
 }
 
-func (x *PushPromotionRequest) String() string {
+func (x *ListReleasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPromotionRequest) ProtoMessage() {}
+func (*ListReleasesRequest) ProtoMessage() {}
 
-func (x *PushPromotionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListReleasesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -234,36 +781,37 @@ func (x *PushPromotionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPromotionRequest.ProtoReflect.Descriptor instead.
-func (*PushPromotionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListReleasesRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PushPromotionRequest) GetApplicationName() string {
+func (x *ListReleasesRequest) GetRepository() string {
 	if x != nil {
-		return x.ApplicationName
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *PushPromotionRequest) GetReleaseName() string {
+func (x *ListReleasesRequest) GetApplicationName() string {
 	if x != nil {
-		return x.ReleaseName
+		return x.ApplicationName
 	}
 	return ""
 }
 
-type PushPromotionResponse struct {
+type ListReleasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status        PushPromotionResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=cresta.releaser.PushPromotionResponse_Status" json:"status,omitempty"`
-	PullRequestId int64                        `protobuf:"varint,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReleaseNames []string `protobuf:"bytes,1,rep,name=release_names,json=releaseNames,proto3" json:"release_names,omitempty"`
+	// SHA of the default branch the releases were read at
+	GitSha string `protobuf:"bytes,2,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
 }
 
-func (x *PushPromotionResponse) Reset() {
-	*x = PushPromotionResponse{}
+func (x *ListReleasesResponse) Reset() {
+	*x = ListReleasesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,13 +819,13 @@ func (x *PushPromotionResponse) Reset() {
 	}
 }
 
-func (x *PushPromotionResponse) String() string {
+func (x *ListReleasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPromotionResponse) ProtoMessage() {}
+func (*ListReleasesResponse) ProtoMessage() {}
 
-func (x *PushPromotionResponse) ProtoReflect() protoreflect.Message {
+func (x *ListReleasesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -289,33 +837,38 @@ func (x *PushPromotionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPromotionResponse.ProtoReflect.Descriptor instead.
-func (*PushPromotionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListReleasesResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PushPromotionResponse) GetStatus() PushPromotionResponse_Status {
+func (x *ListReleasesResponse) GetReleaseNames() []string {
 	if x != nil {
-		return x.Status
+		return x.ReleaseNames
 	}
-	return PushPromotionResponse_UNKNOWN
+	return nil
 }
 
-func (x *PushPromotionResponse) GetPullRequestId() int64 {
+func (x *ListReleasesResponse) GetGitSha() string {
 	if x != nil {
-		return x.PullRequestId
+		return x.GitSha
 	}
-	return 0
+	return ""
 }
 
-type GetAllApplicationStatusRequest struct {
+type ReleaseFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Directory of the file, relative to the release
+	Directory string `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *GetAllApplicationStatusRequest) Reset() {
-	*x = GetAllApplicationStatusRequest{}
+func (x *ReleaseFile) Reset() {
+	*x = ReleaseFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,13 +876,13 @@ func (x *GetAllApplicationStatusRequest) Reset() {
 	}
 }
 
-func (x *GetAllApplicationStatusRequest) String() string {
+func (x *ReleaseFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllApplicationStatusRequest) ProtoMessage() {}
+func (*ReleaseFile) ProtoMessage() {}
 
-func (x *GetAllApplicationStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseFile) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,21 +894,45 @@ func (x *GetAllApplicationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllApplicationStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetAllApplicationStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseFile.ProtoReflect.Descriptor instead.
+func (*ReleaseFile) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{4}
 }
 
-type GetAllApplicationStatusResponse struct {
+func (x *ReleaseFile) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *ReleaseFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetReleaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApplicationStatus []*ApplicationStatus `protobuf:"bytes,1,rep,name=application_status,json=applicationStatus,proto3" json:"application_status,omitempty"`
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
 }
 
-func (x *GetAllApplicationStatusResponse) Reset() {
-	*x = GetAllApplicationStatusResponse{}
+func (x *GetReleaseRequest) Reset() {
+	*x = GetReleaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +940,13 @@ func (x *GetAllApplicationStatusResponse) Reset() {
 	}
 }
 
-func (x *GetAllApplicationStatusResponse) String() string {
+func (x *GetReleaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllApplicationStatusResponse) ProtoMessage() {}
+func (*GetReleaseRequest) ProtoMessage() {}
 
-func (x *GetAllApplicationStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReleaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,29 +958,44 @@ func (x *GetAllApplicationStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllApplicationStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetAllApplicationStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReleaseRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetAllApplicationStatusResponse) GetApplicationStatus() []*ApplicationStatus {
+func (x *GetReleaseRequest) GetRepository() string {
 	if x != nil {
-		return x.ApplicationStatus
+		return x.Repository
 	}
-	return nil
+	return ""
 }
 
-type ApplicationStatus struct {
+func (x *GetReleaseRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *GetReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+type GetReleaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ReleaseStatus []*ReleaseStatus `protobuf:"bytes,2,rep,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	Files []*ReleaseFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// SHA of the default branch the release was read at
+	GitSha string `protobuf:"bytes,2,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
 }
 
-func (x *ApplicationStatus) Reset() {
-	*x = ApplicationStatus{}
+func (x *GetReleaseResponse) Reset() {
+	*x = GetReleaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,13 +1003,13 @@ func (x *ApplicationStatus) Reset() {
 	}
 }
 
-func (x *ApplicationStatus) String() string {
+func (x *GetReleaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplicationStatus) ProtoMessage() {}
+func (*GetReleaseResponse) ProtoMessage() {}
 
-func (x *ApplicationStatus) ProtoReflect() protoreflect.Message {
+func (x *GetReleaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,38 +1021,38 @@ func (x *ApplicationStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplicationStatus.ProtoReflect.Descriptor instead.
-func (*ApplicationStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReleaseResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ApplicationStatus) GetName() string {
+func (x *GetReleaseResponse) GetFiles() []*ReleaseFile {
 	if x != nil {
-		return x.Name
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-func (x *ApplicationStatus) GetReleaseStatus() []*ReleaseStatus {
+func (x *GetReleaseResponse) GetGitSha() string {
 	if x != nil {
-		return x.ReleaseStatus
+		return x.GitSha
 	}
-	return nil
+	return ""
 }
 
-type ReleaseStatus struct {
+type PreviewReleaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status         ReleaseStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=cresta.releaser.ReleaseStatus_Status" json:"status,omitempty"`
-	PrNumber       int64                `protobuf:"varint,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
-	OriginalGitSha string               `protobuf:"bytes,4,opt,name=original_git_sha,json=originalGitSha,proto3" json:"original_git_sha,omitempty"`
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
 }
 
-func (x *ReleaseStatus) Reset() {
-	*x = ReleaseStatus{}
+func (x *PreviewReleaseRequest) Reset() {
+	*x = PreviewReleaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_releaser_Releaser_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,13 +1060,13 @@ func (x *ReleaseStatus) Reset() {
 	}
 }
 
-func (x *ReleaseStatus) String() string {
+func (x *PreviewReleaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseStatus) ProtoMessage() {}
+func (*PreviewReleaseRequest) ProtoMessage() {}
 
-func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
+func (x *PreviewReleaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_releaser_Releaser_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,202 +1078,3625 @@ func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseStatus.ProtoReflect.Descriptor instead.
-func (*ReleaseStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewReleaseRequest.ProtoReflect.Descriptor instead.
+func (*PreviewReleaseRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReleaseStatus) GetName() string {
+func (x *PreviewReleaseRequest) GetRepository() string {
 	if x != nil {
-		return x.Name
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *ReleaseStatus) GetStatus() ReleaseStatus_Status {
+func (x *PreviewReleaseRequest) GetApplicationName() string {
 	if x != nil {
-		return x.Status
+		return x.ApplicationName
 	}
-	return ReleaseStatus_UNKNOWN
+	return ""
 }
 
-func (x *ReleaseStatus) GetPrNumber() int64 {
+func (x *PreviewReleaseRequest) GetReleaseName() string {
 	if x != nil {
-		return x.PrNumber
+		return x.ReleaseName
 	}
-	return 0
+	return ""
 }
 
-func (x *ReleaseStatus) GetOriginalGitSha() string {
+type PreviewReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Files of the release as it is now
+	OldFiles []*ReleaseFile `protobuf:"bytes,1,rep,name=old_files,json=oldFiles,proto3" json:"old_files,omitempty"`
+	// Files of the release after promoting into it
+	NewFiles []*ReleaseFile `protobuf:"bytes,2,rep,name=new_files,json=newFiles,proto3" json:"new_files,omitempty"`
+	// Unified diff from old_files to new_files, empty if they are the same
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	// SHA of the default branch the preview was made at
+	GitSha string `protobuf:"bytes,4,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
+}
+
+func (x *PreviewReleaseResponse) Reset() {
+	*x = PreviewReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewReleaseResponse) ProtoMessage() {}
+
+func (x *PreviewReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewReleaseResponse.ProtoReflect.Descriptor instead.
+func (*PreviewReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewReleaseResponse) GetOldFiles() []*ReleaseFile {
 	if x != nil {
-		return x.OriginalGitSha
+		return x.OldFiles
+	}
+	return nil
+}
+
+func (x *PreviewReleaseResponse) GetNewFiles() []*ReleaseFile {
+	if x != nil {
+		return x.NewFiles
+	}
+	return nil
+}
+
+func (x *PreviewReleaseResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
 	}
 	return ""
 }
 
-var File_rpc_releaser_Releaser_proto protoreflect.FileDescriptor
+func (x *PreviewReleaseResponse) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
 
-var file_rpc_releaser_Releaser_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2f, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x63,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x22, 0x1a,
-	0x0a, 0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xde, 0x01,
-	0x0a, 0x15, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a,
-	0x15, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x57, 0x5f,
-	0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x53, 0x10, 0x03, 0x22, 0x20,
-	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x74, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x45, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x70, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x69, 0x74, 0x53,
-	0x68, 0x61, 0x22, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53,
-	0x45, 0x44, 0x10, 0x02, 0x32, 0xd4, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x72, 0x12, 0x7c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x63,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5e, 0x0a, 0x0d, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72,
-	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6a, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x2f, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
-	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+type NeedsPromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
 }
 
-var (
-	file_rpc_releaser_Releaser_proto_rawDescOnce sync.Once
-	file_rpc_releaser_Releaser_proto_rawDescData = file_rpc_releaser_Releaser_proto_rawDesc
-)
+func (x *NeedsPromotionRequest) Reset() {
+	*x = NeedsPromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_rpc_releaser_Releaser_proto_rawDescGZIP() []byte {
-	file_rpc_releaser_Releaser_proto_rawDescOnce.Do(func() {
-		file_rpc_releaser_Releaser_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_releaser_Releaser_proto_rawDescData)
-	})
-	return file_rpc_releaser_Releaser_proto_rawDescData
+func (x *NeedsPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_rpc_releaser_Releaser_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rpc_releaser_Releaser_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_rpc_releaser_Releaser_proto_goTypes = []interface{}{
-	(PushPromotionResponse_Status)(0),       // 0: cresta.releaser.PushPromotionResponse.Status
-	(ReleaseStatus_Status)(0),               // 1: cresta.releaser.ReleaseStatus.Status
-	(*RefreshRepositoryRequest)(nil),        // 2: cresta.releaser.RefreshRepositoryRequest
-	(*RefreshRepositoryResponse)(nil),       // 3: cresta.releaser.RefreshRepositoryResponse
-	(*PushPromotionRequest)(nil),            // 4: cresta.releaser.PushPromotionRequest
-	(*PushPromotionResponse)(nil),           // 5: cresta.releaser.PushPromotionResponse
-	(*GetAllApplicationStatusRequest)(nil),  // 6: cresta.releaser.GetAllApplicationStatusRequest
-	(*GetAllApplicationStatusResponse)(nil), // 7: cresta.releaser.GetAllApplicationStatusResponse
-	(*ApplicationStatus)(nil),               // 8: cresta.releaser.ApplicationStatus
-	(*ReleaseStatus)(nil),                   // 9: cresta.releaser.ReleaseStatus
+func (*NeedsPromotionRequest) ProtoMessage() {}
+
+func (x *NeedsPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_rpc_releaser_Releaser_proto_depIdxs = []int32{
-	0, // 0: cresta.releaser.PushPromotionResponse.status:type_name -> cresta.releaser.PushPromotionResponse.Status
-	8, // 1: cresta.releaser.GetAllApplicationStatusResponse.application_status:type_name -> cresta.releaser.ApplicationStatus
-	9, // 2: cresta.releaser.ApplicationStatus.release_status:type_name -> cresta.releaser.ReleaseStatus
-	1, // 3: cresta.releaser.ReleaseStatus.status:type_name -> cresta.releaser.ReleaseStatus.Status
-	6, // 4: cresta.releaser.Releaser.GetAllApplicationStatus:input_type -> cresta.releaser.GetAllApplicationStatusRequest
-	4, // 5: cresta.releaser.Releaser.PushPromotion:input_type -> cresta.releaser.PushPromotionRequest
-	2, // 6: cresta.releaser.Releaser.RefreshRepository:input_type -> cresta.releaser.RefreshRepositoryRequest
-	7, // 7: cresta.releaser.Releaser.GetAllApplicationStatus:output_type -> cresta.releaser.GetAllApplicationStatusResponse
-	5, // 8: cresta.releaser.Releaser.PushPromotion:output_type -> cresta.releaser.PushPromotionResponse
-	3, // 9: cresta.releaser.Releaser.RefreshRepository:output_type -> cresta.releaser.RefreshRepositoryResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use NeedsPromotionRequest.ProtoReflect.Descriptor instead.
+func (*NeedsPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_rpc_releaser_Releaser_proto_init() }
-func file_rpc_releaser_Releaser_proto_init() {
-	if File_rpc_releaser_Releaser_proto != nil {
-		return
+func (x *NeedsPromotionRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_releaser_Releaser_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRepositoryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_rpc_releaser_Releaser_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRepositoryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+	return ""
+}
+
+func (x *NeedsPromotionRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *NeedsPromotionRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+type NeedsPromotionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NeedsPromotion bool `protobuf:"varint,1,opt,name=needs_promotion,json=needsPromotion,proto3" json:"needs_promotion,omitempty"`
+	// SHA of the default branch the check was made at
+	GitSha string `protobuf:"bytes,2,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
+}
+
+func (x *NeedsPromotionResponse) Reset() {
+	*x = NeedsPromotionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeedsPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeedsPromotionResponse) ProtoMessage() {}
+
+func (x *NeedsPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeedsPromotionResponse.ProtoReflect.Descriptor instead.
+func (*NeedsPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NeedsPromotionResponse) GetNeedsPromotion() bool {
+	if x != nil {
+		return x.NeedsPromotion
+	}
+	return false
+}
+
+func (x *NeedsPromotionResponse) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
+type ApprovePromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the pull request belongs to.  May be empty if the server only manages one repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// The open pull request that promotes this application and release is approved, unless pull_request_id is set
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	PullRequestId   int64  `protobuf:"varint,4,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Login of who is approving the promotion
+	Approver string `protobuf:"bytes,5,opt,name=approver,proto3" json:"approver,omitempty"`
+	// Body of the review.  Defaults to a message naming the releaser.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ApprovePromotionRequest) Reset() {
+	*x = ApprovePromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePromotionRequest) ProtoMessage() {}
+
+func (x *ApprovePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePromotionRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApprovePromotionRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ApprovePromotionRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ApprovePromotionRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ApprovePromotionRequest) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *ApprovePromotionRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApprovePromotionRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApprovePromotionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PullRequestId int64 `protobuf:"varint,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+}
+
+func (x *ApprovePromotionResponse) Reset() {
+	*x = ApprovePromotionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePromotionResponse) ProtoMessage() {}
+
+func (x *ApprovePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePromotionResponse.ProtoReflect.Descriptor instead.
+func (*ApprovePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApprovePromotionResponse) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+type MergePromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the pull request belongs to.  May be empty if the server only manages one repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// The open pull request that promotes this application and release is merged, unless pull_request_id is set
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	PullRequestId   int64  `protobuf:"varint,4,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+}
+
+func (x *MergePromotionRequest) Reset() {
+	*x = MergePromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePromotionRequest) ProtoMessage() {}
+
+func (x *MergePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePromotionRequest.ProtoReflect.Descriptor instead.
+func (*MergePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MergePromotionRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *MergePromotionRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *MergePromotionRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *MergePromotionRequest) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+type MergePromotionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PullRequestId int64 `protobuf:"varint,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+}
+
+func (x *MergePromotionResponse) Reset() {
+	*x = MergePromotionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePromotionResponse) ProtoMessage() {}
+
+func (x *MergePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePromotionResponse.ProtoReflect.Descriptor instead.
+func (*MergePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MergePromotionResponse) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+type StartPromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Who asked for the promotion.  Recorded in the promotion commit, and defaults to the server's git author.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Overwrite commits on the release branch that the releaser did not create
+	OverwriteForeignCommits bool `protobuf:"varint,5,opt,name=overwrite_foreign_commits,json=overwriteForeignCommits,proto3" json:"overwrite_foreign_commits,omitempty"`
+}
+
+func (x *StartPromotionRequest) Reset() {
+	*x = StartPromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPromotionRequest) ProtoMessage() {}
+
+func (x *StartPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPromotionRequest.ProtoReflect.Descriptor instead.
+func (*StartPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StartPromotionRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *StartPromotionRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *StartPromotionRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *StartPromotionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StartPromotionRequest) GetOverwriteForeignCommits() bool {
+	if x != nil {
+		return x.OverwriteForeignCommits
+	}
+	return false
+}
+
+type StartPromotionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// True if the same promotion was already queued, and job is that promotion
+	Deduplicated bool `protobuf:"varint,2,opt,name=deduplicated,proto3" json:"deduplicated,omitempty"`
+}
+
+func (x *StartPromotionResponse) Reset() {
+	*x = StartPromotionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPromotionResponse) ProtoMessage() {}
+
+func (x *StartPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPromotionResponse.ProtoReflect.Descriptor instead.
+func (*StartPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StartPromotionResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *StartPromotionResponse) GetDeduplicated() bool {
+	if x != nil {
+		return x.Deduplicated
+	}
+	return false
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list jobs of this repository, application and release, for those that are set
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Maximum number of jobs to return.  Defaults to 50.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListJobsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repository      string    `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string    `protobuf:"bytes,3,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string    `protobuf:"bytes,4,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Actor           string    `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	State           Job_State `protobuf:"varint,6,opt,name=state,proto3,enum=cresta.releaser.Job_State" json:"state,omitempty"`
+	// Steps the job has started, in order
+	Steps []*JobStep `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Log lines of the job, with credentials removed
+	Logs []string `protobuf:"bytes,8,rep,name=logs,proto3" json:"logs,omitempty"`
+	// What the promotion did, once the job succeeded
+	Result *PushPromotionResponse `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`
+	// Why the job failed
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// Times in RFC 3339 format.  started_at and finished_at are empty until the job gets there.
+	CreatedAt  string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt  string `protobuf:"bytes,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt string `protobuf:"bytes,13,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *Job) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *Job) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *Job) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Job) GetState() Job_State {
+	if x != nil {
+		return x.State
+	}
+	return Job_STATE_UNKNOWN
+}
+
+func (x *Job) GetSteps() []*JobStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Job) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Job) GetResult() *PushPromotionResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Job) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type JobStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of fetch, checkout, branch, apply, commit, push and pull_request
+	Name       string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State      JobStep_State `protobuf:"varint,2,opt,name=state,proto3,enum=cresta.releaser.JobStep_State" json:"state,omitempty"`
+	StartedAt  string        `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt string        `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *JobStep) Reset() {
+	*x = JobStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStep) ProtoMessage() {}
+
+func (x *JobStep) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStep.ProtoReflect.Descriptor instead.
+func (*JobStep) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JobStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobStep) GetState() JobStep_State {
+	if x != nil {
+		return x.State
+	}
+	return JobStep_STATE_UNKNOWN
+}
+
+func (x *JobStep) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobStep) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type ListDeploymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository      string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string `protobuf:"bytes,2,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,3,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+}
+
+func (x *ListDeploymentsRequest) Reset() {
+	*x = ListDeploymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsRequest) ProtoMessage() {}
+
+func (x *ListDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListDeploymentsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ListDeploymentsRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+type ListDeploymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployments []*Deployment `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+}
+
+func (x *ListDeploymentsResponse) Reset() {
+	*x = ListDeploymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeploymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeploymentsResponse) ProtoMessage() {}
+
+func (x *ListDeploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeploymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListDeploymentsResponse) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+type Deployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Commit that was deployed
+	Sha             string `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	Environment     string `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	ApplicationName string `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,5,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Release the application was promoted from
+	FromReleaseName string `protobuf:"bytes,6,opt,name=from_release_name,json=fromReleaseName,proto3" json:"from_release_name,omitempty"`
+	// Git SHA the promoted content was originally released at
+	OriginalGitSha string           `protobuf:"bytes,7,opt,name=original_git_sha,json=originalGitSha,proto3" json:"original_git_sha,omitempty"`
+	PullRequestId  int64            `protobuf:"varint,8,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	State          Deployment_State `protobuf:"varint,9,opt,name=state,proto3,enum=cresta.releaser.Deployment_State" json:"state,omitempty"`
+	// When the deployment was created, in RFC 3339 format
+	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deployment) ProtoMessage() {}
+
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Deployment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Deployment) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *Deployment) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Deployment) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *Deployment) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *Deployment) GetFromReleaseName() string {
+	if x != nil {
+		return x.FromReleaseName
+	}
+	return ""
+}
+
+func (x *Deployment) GetOriginalGitSha() string {
+	if x != nil {
+		return x.OriginalGitSha
+	}
+	return ""
+}
+
+func (x *Deployment) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *Deployment) GetState() Deployment_State {
+	if x != nil {
+		return x.State
+	}
+	return Deployment_STATE_UNKNOWN
+}
+
+func (x *Deployment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SetDeploymentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the deployment belongs to.  May be empty if the server only manages one repository.
+	Repository   string           `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	DeploymentId int64            `protobuf:"varint,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	State        Deployment_State `protobuf:"varint,3,opt,name=state,proto3,enum=cresta.releaser.Deployment_State" json:"state,omitempty"`
+	Description  string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SetDeploymentStatusRequest) Reset() {
+	*x = SetDeploymentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDeploymentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeploymentStatusRequest) ProtoMessage() {}
+
+func (x *SetDeploymentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeploymentStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDeploymentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetDeploymentStatusRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *SetDeploymentStatusRequest) GetDeploymentId() int64 {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return 0
+}
+
+func (x *SetDeploymentStatusRequest) GetState() Deployment_State {
+	if x != nil {
+		return x.State
+	}
+	return Deployment_STATE_UNKNOWN
+}
+
+func (x *SetDeploymentStatusRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetDeploymentStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDeploymentStatusResponse) Reset() {
+	*x = SetDeploymentStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDeploymentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeploymentStatusResponse) ProtoMessage() {}
+
+func (x *SetDeploymentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeploymentStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetDeploymentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{27}
+}
+
+type ReconcilePullRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository to reconcile.  Empty reconciles every repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Only report the pull requests that would be closed
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ReconcilePullRequestsRequest) Reset() {
+	*x = ReconcilePullRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePullRequestsRequest) ProtoMessage() {}
+
+func (x *ReconcilePullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcilePullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReconcilePullRequestsRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ReconcilePullRequestsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReconcilePullRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClosedPullRequests []*ClosedPullRequest `protobuf:"bytes,1,rep,name=closed_pull_requests,json=closedPullRequests,proto3" json:"closed_pull_requests,omitempty"`
+}
+
+func (x *ReconcilePullRequestsResponse) Reset() {
+	*x = ReconcilePullRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePullRequestsResponse) ProtoMessage() {}
+
+func (x *ReconcilePullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcilePullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReconcilePullRequestsResponse) GetClosedPullRequests() []*ClosedPullRequest {
+	if x != nil {
+		return x.ClosedPullRequests
+	}
+	return nil
+}
+
+type ClosedPullRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository    string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId int64  `protobuf:"varint,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Branch        string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Why the pull request is no longer needed
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ClosedPullRequest) Reset() {
+	*x = ClosedPullRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosedPullRequest) ProtoMessage() {}
+
+func (x *ClosedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosedPullRequest.ProtoReflect.Descriptor instead.
+func (*ClosedPullRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClosedPullRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ClosedPullRequest) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *ClosedPullRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ClosedPullRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EnableAutoMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository the pull request belongs to.  May be empty if the server only manages one repository.
+	Repository    string                             `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	PullRequestId int64                              `protobuf:"varint,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	MergeMethod   EnableAutoMergeRequest_MergeMethod `protobuf:"varint,3,opt,name=merge_method,json=mergeMethod,proto3,enum=cresta.releaser.EnableAutoMergeRequest_MergeMethod" json:"merge_method,omitempty"`
+}
+
+func (x *EnableAutoMergeRequest) Reset() {
+	*x = EnableAutoMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableAutoMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableAutoMergeRequest) ProtoMessage() {}
+
+func (x *EnableAutoMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableAutoMergeRequest.ProtoReflect.Descriptor instead.
+func (*EnableAutoMergeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EnableAutoMergeRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *EnableAutoMergeRequest) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *EnableAutoMergeRequest) GetMergeMethod() EnableAutoMergeRequest_MergeMethod {
+	if x != nil {
+		return x.MergeMethod
+	}
+	return EnableAutoMergeRequest_SQUASH
+}
+
+type EnableAutoMergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EnableAutoMergeResponse) Reset() {
+	*x = EnableAutoMergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableAutoMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableAutoMergeResponse) ProtoMessage() {}
+
+func (x *EnableAutoMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableAutoMergeResponse.ProtoReflect.Descriptor instead.
+func (*EnableAutoMergeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{32}
+}
+
+type RefreshRepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository to refresh.  Empty refreshes every repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *RefreshRepositoryRequest) Reset() {
+	*x = RefreshRepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshRepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRepositoryRequest) ProtoMessage() {}
+
+func (x *RefreshRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RefreshRepositoryRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type RefreshRepositoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshRepositoryResponse) Reset() {
+	*x = RefreshRepositoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshRepositoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRepositoryResponse) ProtoMessage() {}
+
+func (x *RefreshRepositoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*RefreshRepositoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{34}
+}
+
+type PushPromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationName string `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Repository the application lives in.  May be empty if the server only manages one repository.
+	Repository string `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Who asked for the promotion.  Recorded in the promotion commit, and defaults to the server's git author.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Overwrite commits on the release branch that the releaser did not create.  Without this, the promotion fails
+	// with FailedPrecondition if anyone else pushed to the release branch.
+	OverwriteForeignCommits bool `protobuf:"varint,5,opt,name=overwrite_foreign_commits,json=overwriteForeignCommits,proto3" json:"overwrite_foreign_commits,omitempty"`
+}
+
+func (x *PushPromotionRequest) Reset() {
+	*x = PushPromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPromotionRequest) ProtoMessage() {}
+
+func (x *PushPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPromotionRequest.ProtoReflect.Descriptor instead.
+func (*PushPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PushPromotionRequest) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *PushPromotionRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *PushPromotionRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *PushPromotionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PushPromotionRequest) GetOverwriteForeignCommits() bool {
+	if x != nil {
+		return x.OverwriteForeignCommits
+	}
+	return false
+}
+
+type PushPromotionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        PushPromotionResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=cresta.releaser.PushPromotionResponse_Status" json:"status,omitempty"`
+	PullRequestId int64                        `protobuf:"varint,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// SHA of the commit pushed to the default branch, for DIRECT_COMMIT
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+}
+
+func (x *PushPromotionResponse) Reset() {
+	*x = PushPromotionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPromotionResponse) ProtoMessage() {}
+
+func (x *PushPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPromotionResponse.ProtoReflect.Descriptor instead.
+func (*PushPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PushPromotionResponse) GetStatus() PushPromotionResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PushPromotionResponse_UNKNOWN
+}
+
+func (x *PushPromotionResponse) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *PushPromotionResponse) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+type GetAllApplicationStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository to get status for.  Empty returns the status of every repository.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *GetAllApplicationStatusRequest) Reset() {
+	*x = GetAllApplicationStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllApplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllApplicationStatusRequest) ProtoMessage() {}
+
+func (x *GetAllApplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllApplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAllApplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAllApplicationStatusRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type GetAllApplicationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationStatus []*ApplicationStatus `protobuf:"bytes,1,rep,name=application_status,json=applicationStatus,proto3" json:"application_status,omitempty"`
+	// The snapshot each repository's status was computed from
+	Snapshots []*StatusSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *GetAllApplicationStatusResponse) Reset() {
+	*x = GetAllApplicationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllApplicationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllApplicationStatusResponse) ProtoMessage() {}
+
+func (x *GetAllApplicationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllApplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAllApplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAllApplicationStatusResponse) GetApplicationStatus() []*ApplicationStatus {
+	if x != nil {
+		return x.ApplicationStatus
+	}
+	return nil
+}
+
+func (x *GetAllApplicationStatusResponse) GetSnapshots() []*StatusSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// StatusSnapshot describes the cached release status of a repository.  Release status is only computed again when the
+// default branch moves, and then only for the applications that changed.  Pull requests are looked up on every request.
+type StatusSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// SHA of the default branch the status was computed at
+	GitSha string `protobuf:"bytes,2,opt,name=git_sha,json=gitSha,proto3" json:"git_sha,omitempty"`
+	// When the status was computed, in RFC 3339 format
+	ComputedAt string `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	// How long computing the status took, in milliseconds
+	ComputeDurationMs int64 `protobuf:"varint,4,opt,name=compute_duration_ms,json=computeDurationMs,proto3" json:"compute_duration_ms,omitempty"`
+	// Applications whose status was computed for this snapshot rather than reused from the previous one
+	RecomputedApplications []string `protobuf:"bytes,5,rep,name=recomputed_applications,json=recomputedApplications,proto3" json:"recomputed_applications,omitempty"`
+}
+
+func (x *StatusSnapshot) Reset() {
+	*x = StatusSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusSnapshot) ProtoMessage() {}
+
+func (x *StatusSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusSnapshot.ProtoReflect.Descriptor instead.
+func (*StatusSnapshot) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StatusSnapshot) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *StatusSnapshot) GetGitSha() string {
+	if x != nil {
+		return x.GitSha
+	}
+	return ""
+}
+
+func (x *StatusSnapshot) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+func (x *StatusSnapshot) GetComputeDurationMs() int64 {
+	if x != nil {
+		return x.ComputeDurationMs
+	}
+	return 0
+}
+
+func (x *StatusSnapshot) GetRecomputedApplications() []string {
+	if x != nil {
+		return x.RecomputedApplications
+	}
+	return nil
+}
+
+type ApplicationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReleaseStatus []*ReleaseStatus `protobuf:"bytes,2,rep,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	Repository    string           `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *ApplicationStatus) Reset() {
+	*x = ApplicationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationStatus) ProtoMessage() {}
+
+func (x *ApplicationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationStatus.ProtoReflect.Descriptor instead.
+func (*ApplicationStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApplicationStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplicationStatus) GetReleaseStatus() []*ReleaseStatus {
+	if x != nil {
+		return x.ReleaseStatus
+	}
+	return nil
+}
+
+func (x *ApplicationStatus) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type ReleaseStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status         ReleaseStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=cresta.releaser.ReleaseStatus_Status" json:"status,omitempty"`
+	PrNumber       int64                `protobuf:"varint,3,opt,name=pr_number,json=prNumber,proto3" json:"pr_number,omitempty"`
+	OriginalGitSha string               `protobuf:"bytes,4,opt,name=original_git_sha,json=originalGitSha,proto3" json:"original_git_sha,omitempty"`
+	// Details of pr_number, if there is a PR
+	PullRequest *PullRequestStatus `protobuf:"bytes,5,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+}
+
+func (x *ReleaseStatus) Reset() {
+	*x = ReleaseStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStatus) ProtoMessage() {}
+
+func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStatus.ProtoReflect.Descriptor instead.
+func (*ReleaseStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReleaseStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseStatus) GetStatus() ReleaseStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ReleaseStatus_UNKNOWN
+}
+
+func (x *ReleaseStatus) GetPrNumber() int64 {
+	if x != nil {
+		return x.PrNumber
+	}
+	return 0
+}
+
+func (x *ReleaseStatus) GetOriginalGitSha() string {
+	if x != nil {
+		return x.OriginalGitSha
+	}
+	return ""
+}
+
+func (x *ReleaseStatus) GetPullRequest() *PullRequestStatus {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type PullRequestStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State          PullRequestStatus_State          `protobuf:"varint,1,opt,name=state,proto3,enum=cresta.releaser.PullRequestStatus_State" json:"state,omitempty"`
+	ReviewDecision PullRequestStatus_ReviewDecision `protobuf:"varint,2,opt,name=review_decision,json=reviewDecision,proto3,enum=cresta.releaser.PullRequestStatus_ReviewDecision" json:"review_decision,omitempty"`
+	// Number of reviewers whose latest review approves the PR
+	Approvals int32                       `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	Mergeable PullRequestStatus_Mergeable `protobuf:"varint,4,opt,name=mergeable,proto3,enum=cresta.releaser.PullRequestStatus_Mergeable" json:"mergeable,omitempty"`
+	Checks    PullRequestStatus_Checks    `protobuf:"varint,5,opt,name=checks,proto3,enum=cresta.releaser.PullRequestStatus_Checks" json:"checks,omitempty"`
+	// True while the PR is held as a draft
+	Draft bool `protobuf:"varint,6,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *PullRequestStatus) Reset() {
+	*x = PullRequestStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullRequestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestStatus) ProtoMessage() {}
+
+func (x *PullRequestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestStatus.ProtoReflect.Descriptor instead.
+func (*PullRequestStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PullRequestStatus) GetState() PullRequestStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return PullRequestStatus_STATE_UNKNOWN
+}
+
+func (x *PullRequestStatus) GetReviewDecision() PullRequestStatus_ReviewDecision {
+	if x != nil {
+		return x.ReviewDecision
+	}
+	return PullRequestStatus_REVIEW_DECISION_NONE
+}
+
+func (x *PullRequestStatus) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *PullRequestStatus) GetMergeable() PullRequestStatus_Mergeable {
+	if x != nil {
+		return x.Mergeable
+	}
+	return PullRequestStatus_MERGEABLE_UNKNOWN
+}
+
+func (x *PullRequestStatus) GetChecks() PullRequestStatus_Checks {
+	if x != nil {
+		return x.Checks
+	}
+	return PullRequestStatus_CHECKS_NONE
+}
+
+func (x *PullRequestStatus) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+// StatusEvent is a change to the status of a release, streamed as a server-sent event from /events
+type StatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resume token.  Reconnect with it as the Last-Event-ID header to receive the events after this one.
+	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            StatusEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=cresta.releaser.StatusEvent_Type" json:"type,omitempty"`
+	Repository      string           `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+	ApplicationName string           `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	ReleaseName     string           `protobuf:"bytes,5,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Status of the release after the change.  Empty for RELEASE_REMOVED and RESET.
+	ReleaseStatus *ReleaseStatus `protobuf:"bytes,6,opt,name=release_status,json=releaseStatus,proto3" json:"release_status,omitempty"`
+	// Status of the release before the change.  Empty for RELEASE_ADDED and RESET.
+	PreviousReleaseStatus *ReleaseStatus `protobuf:"bytes,7,opt,name=previous_release_status,json=previousReleaseStatus,proto3" json:"previous_release_status,omitempty"`
+	// The pull request the event is about, for pull request and hold events
+	PullRequestId int64 `protobuf:"varint,8,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// When the change was noticed, in RFC 3339 format
+	Time string `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StatusEvent) Reset() {
+	*x = StatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_releaser_Releaser_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEvent) ProtoMessage() {}
+
+func (x *StatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_releaser_Releaser_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEvent.ProtoReflect.Descriptor instead.
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_releaser_Releaser_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatusEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetType() StatusEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return StatusEvent_TYPE_UNKNOWN
+}
+
+func (x *StatusEvent) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetReleaseStatus() *ReleaseStatus {
+	if x != nil {
+		return x.ReleaseStatus
+	}
+	return nil
+}
+
+func (x *StatusEvent) GetPreviousReleaseStatus() *ReleaseStatus {
+	if x != nil {
+		return x.PreviousReleaseStatus
+	}
+	return nil
+}
+
+func (x *StatusEvent) GetPullRequestId() int64 {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return 0
+}
+
+func (x *StatusEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+var File_rpc_releaser_Releaser_proto protoreflect.FileDescriptor
+
+var file_rpc_releaser_Releaser_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2f, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x22, 0x39,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x60, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x60, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74,
+	0x53, 0x68, 0x61, 0x22, 0x59, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x81,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbb, 0x01,
+	0x0a, 0x16, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x15,
+	0x4e, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x4e, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22,
+	0xe5, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c,
+	0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x15,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x16, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd7, 0x01,
+	0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x6f,
+	0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17,
+	0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x26, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22,
+	0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xac, 0x04, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x66, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x22, 0xe4, 0x01, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4f, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x86, 0x01, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x98,
+	0x04, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x47,
+	0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
+	0x04, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49,
+	0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x07, 0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x1c, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x75, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x75, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x14, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x12, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x22, 0x30, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x51, 0x55, 0x41, 0x53, 0x48, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x42, 0x41, 0x53, 0x45,
+	0x10, 0x02, 0x22, 0x19, 0x0a, 0x17, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a,
+	0x18, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22,
+	0xab, 0x02, 0x0a, 0x15, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x55, 0x4c, 0x4c,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45,
+	0x57, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x53, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x50,
+	0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x05, 0x22, 0x40, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0xb3, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f,
+	0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xa2, 0x02, 0x0a,
+	0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x69, 0x74, 0x5f, 0x73,
+	0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x47, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x45, 0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10,
+	0x02, 0x22, 0x88, 0x06, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x72, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x12, 0x4a, 0x0a, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x41, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x4e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x56,
+	0x49, 0x45, 0x57, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x44, 0x45,
+	0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x44, 0x45, 0x43, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x53, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x56, 0x49,
+	0x45, 0x57, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x49,
+	0x45, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x22, 0x56, 0x0a,
+	0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x55, 0x0a, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x22, 0xd4, 0x05, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x56, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x02, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1c,
+	0x0a, 0x18, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x0b, 0x32, 0xb8, 0x0d, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x12, 0x7c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x0d, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
+	0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x27, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x76, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x75, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x0e, 0x4e, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x65, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x65,
+	0x64, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x1e, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30,
+	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x2f, 0x63, 0x72, 0x65, 0x73, 0x74, 0x61, 0x2d, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_releaser_Releaser_proto_rawDescOnce sync.Once
+	file_rpc_releaser_Releaser_proto_rawDescData = file_rpc_releaser_Releaser_proto_rawDesc
+)
+
+func file_rpc_releaser_Releaser_proto_rawDescGZIP() []byte {
+	file_rpc_releaser_Releaser_proto_rawDescOnce.Do(func() {
+		file_rpc_releaser_Releaser_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_releaser_Releaser_proto_rawDescData)
+	})
+	return file_rpc_releaser_Releaser_proto_rawDescData
+}
+
+var file_rpc_releaser_Releaser_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_rpc_releaser_Releaser_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_rpc_releaser_Releaser_proto_goTypes = []interface{}{
+	(Job_State)(0),                          // 0: cresta.releaser.Job.State
+	(JobStep_State)(0),                      // 1: cresta.releaser.JobStep.State
+	(Deployment_State)(0),                   // 2: cresta.releaser.Deployment.State
+	(EnableAutoMergeRequest_MergeMethod)(0), // 3: cresta.releaser.EnableAutoMergeRequest.MergeMethod
+	(PushPromotionResponse_Status)(0),       // 4: cresta.releaser.PushPromotionResponse.Status
+	(ReleaseStatus_Status)(0),               // 5: cresta.releaser.ReleaseStatus.Status
+	(PullRequestStatus_State)(0),            // 6: cresta.releaser.PullRequestStatus.State
+	(PullRequestStatus_ReviewDecision)(0),   // 7: cresta.releaser.PullRequestStatus.ReviewDecision
+	(PullRequestStatus_Mergeable)(0),        // 8: cresta.releaser.PullRequestStatus.Mergeable
+	(PullRequestStatus_Checks)(0),           // 9: cresta.releaser.PullRequestStatus.Checks
+	(StatusEvent_Type)(0),                   // 10: cresta.releaser.StatusEvent.Type
+	(*ListApplicationsRequest)(nil),         // 11: cresta.releaser.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),        // 12: cresta.releaser.ListApplicationsResponse
+	(*ListReleasesRequest)(nil),             // 13: cresta.releaser.ListReleasesRequest
+	(*ListReleasesResponse)(nil),            // 14: cresta.releaser.ListReleasesResponse
+	(*ReleaseFile)(nil),                     // 15: cresta.releaser.ReleaseFile
+	(*GetReleaseRequest)(nil),               // 16: cresta.releaser.GetReleaseRequest
+	(*GetReleaseResponse)(nil),              // 17: cresta.releaser.GetReleaseResponse
+	(*PreviewReleaseRequest)(nil),           // 18: cresta.releaser.PreviewReleaseRequest
+	(*PreviewReleaseResponse)(nil),          // 19: cresta.releaser.PreviewReleaseResponse
+	(*NeedsPromotionRequest)(nil),           // 20: cresta.releaser.NeedsPromotionRequest
+	(*NeedsPromotionResponse)(nil),          // 21: cresta.releaser.NeedsPromotionResponse
+	(*ApprovePromotionRequest)(nil),         // 22: cresta.releaser.ApprovePromotionRequest
+	(*ApprovePromotionResponse)(nil),        // 23: cresta.releaser.ApprovePromotionResponse
+	(*MergePromotionRequest)(nil),           // 24: cresta.releaser.MergePromotionRequest
+	(*MergePromotionResponse)(nil),          // 25: cresta.releaser.MergePromotionResponse
+	(*StartPromotionRequest)(nil),           // 26: cresta.releaser.StartPromotionRequest
+	(*StartPromotionResponse)(nil),          // 27: cresta.releaser.StartPromotionResponse
+	(*GetJobRequest)(nil),                   // 28: cresta.releaser.GetJobRequest
+	(*GetJobResponse)(nil),                  // 29: cresta.releaser.GetJobResponse
+	(*ListJobsRequest)(nil),                 // 30: cresta.releaser.ListJobsRequest
+	(*ListJobsResponse)(nil),                // 31: cresta.releaser.ListJobsResponse
+	(*Job)(nil),                             // 32: cresta.releaser.Job
+	(*JobStep)(nil),                         // 33: cresta.releaser.JobStep
+	(*ListDeploymentsRequest)(nil),          // 34: cresta.releaser.ListDeploymentsRequest
+	(*ListDeploymentsResponse)(nil),         // 35: cresta.releaser.ListDeploymentsResponse
+	(*Deployment)(nil),                      // 36: cresta.releaser.Deployment
+	(*SetDeploymentStatusRequest)(nil),      // 37: cresta.releaser.SetDeploymentStatusRequest
+	(*SetDeploymentStatusResponse)(nil),     // 38: cresta.releaser.SetDeploymentStatusResponse
+	(*ReconcilePullRequestsRequest)(nil),    // 39: cresta.releaser.ReconcilePullRequestsRequest
+	(*ReconcilePullRequestsResponse)(nil),   // 40: cresta.releaser.ReconcilePullRequestsResponse
+	(*ClosedPullRequest)(nil),               // 41: cresta.releaser.ClosedPullRequest
+	(*EnableAutoMergeRequest)(nil),          // 42: cresta.releaser.EnableAutoMergeRequest
+	(*EnableAutoMergeResponse)(nil),         // 43: cresta.releaser.EnableAutoMergeResponse
+	(*RefreshRepositoryRequest)(nil),        // 44: cresta.releaser.RefreshRepositoryRequest
+	(*RefreshRepositoryResponse)(nil),       // 45: cresta.releaser.RefreshRepositoryResponse
+	(*PushPromotionRequest)(nil),            // 46: cresta.releaser.PushPromotionRequest
+	(*PushPromotionResponse)(nil),           // 47: cresta.releaser.PushPromotionResponse
+	(*GetAllApplicationStatusRequest)(nil),  // 48: cresta.releaser.GetAllApplicationStatusRequest
+	(*GetAllApplicationStatusResponse)(nil), // 49: cresta.releaser.GetAllApplicationStatusResponse
+	(*StatusSnapshot)(nil),                  // 50: cresta.releaser.StatusSnapshot
+	(*ApplicationStatus)(nil),               // 51: cresta.releaser.ApplicationStatus
+	(*ReleaseStatus)(nil),                   // 52: cresta.releaser.ReleaseStatus
+	(*PullRequestStatus)(nil),               // 53: cresta.releaser.PullRequestStatus
+	(*StatusEvent)(nil),                     // 54: cresta.releaser.StatusEvent
+}
+var file_rpc_releaser_Releaser_proto_depIdxs = []int32{
+	15, // 0: cresta.releaser.GetReleaseResponse.files:type_name -> cresta.releaser.ReleaseFile
+	15, // 1: cresta.releaser.PreviewReleaseResponse.old_files:type_name -> cresta.releaser.ReleaseFile
+	15, // 2: cresta.releaser.PreviewReleaseResponse.new_files:type_name -> cresta.releaser.ReleaseFile
+	32, // 3: cresta.releaser.StartPromotionResponse.job:type_name -> cresta.releaser.Job
+	32, // 4: cresta.releaser.GetJobResponse.job:type_name -> cresta.releaser.Job
+	32, // 5: cresta.releaser.ListJobsResponse.jobs:type_name -> cresta.releaser.Job
+	0,  // 6: cresta.releaser.Job.state:type_name -> cresta.releaser.Job.State
+	33, // 7: cresta.releaser.Job.steps:type_name -> cresta.releaser.JobStep
+	47, // 8: cresta.releaser.Job.result:type_name -> cresta.releaser.PushPromotionResponse
+	1,  // 9: cresta.releaser.JobStep.state:type_name -> cresta.releaser.JobStep.State
+	36, // 10: cresta.releaser.ListDeploymentsResponse.deployments:type_name -> cresta.releaser.Deployment
+	2,  // 11: cresta.releaser.Deployment.state:type_name -> cresta.releaser.Deployment.State
+	2,  // 12: cresta.releaser.SetDeploymentStatusRequest.state:type_name -> cresta.releaser.Deployment.State
+	41, // 13: cresta.releaser.ReconcilePullRequestsResponse.closed_pull_requests:type_name -> cresta.releaser.ClosedPullRequest
+	3,  // 14: cresta.releaser.EnableAutoMergeRequest.merge_method:type_name -> cresta.releaser.EnableAutoMergeRequest.MergeMethod
+	4,  // 15: cresta.releaser.PushPromotionResponse.status:type_name -> cresta.releaser.PushPromotionResponse.Status
+	51, // 16: cresta.releaser.GetAllApplicationStatusResponse.application_status:type_name -> cresta.releaser.ApplicationStatus
+	50, // 17: cresta.releaser.GetAllApplicationStatusResponse.snapshots:type_name -> cresta.releaser.StatusSnapshot
+	52, // 18: cresta.releaser.ApplicationStatus.release_status:type_name -> cresta.releaser.ReleaseStatus
+	5,  // 19: cresta.releaser.ReleaseStatus.status:type_name -> cresta.releaser.ReleaseStatus.Status
+	53, // 20: cresta.releaser.ReleaseStatus.pull_request:type_name -> cresta.releaser.PullRequestStatus
+	6,  // 21: cresta.releaser.PullRequestStatus.state:type_name -> cresta.releaser.PullRequestStatus.State
+	7,  // 22: cresta.releaser.PullRequestStatus.review_decision:type_name -> cresta.releaser.PullRequestStatus.ReviewDecision
+	8,  // 23: cresta.releaser.PullRequestStatus.mergeable:type_name -> cresta.releaser.PullRequestStatus.Mergeable
+	9,  // 24: cresta.releaser.PullRequestStatus.checks:type_name -> cresta.releaser.PullRequestStatus.Checks
+	10, // 25: cresta.releaser.StatusEvent.type:type_name -> cresta.releaser.StatusEvent.Type
+	52, // 26: cresta.releaser.StatusEvent.release_status:type_name -> cresta.releaser.ReleaseStatus
+	52, // 27: cresta.releaser.StatusEvent.previous_release_status:type_name -> cresta.releaser.ReleaseStatus
+	48, // 28: cresta.releaser.Releaser.GetAllApplicationStatus:input_type -> cresta.releaser.GetAllApplicationStatusRequest
+	46, // 29: cresta.releaser.Releaser.PushPromotion:input_type -> cresta.releaser.PushPromotionRequest
+	44, // 30: cresta.releaser.Releaser.RefreshRepository:input_type -> cresta.releaser.RefreshRepositoryRequest
+	42, // 31: cresta.releaser.Releaser.EnableAutoMerge:input_type -> cresta.releaser.EnableAutoMergeRequest
+	39, // 32: cresta.releaser.Releaser.ReconcilePullRequests:input_type -> cresta.releaser.ReconcilePullRequestsRequest
+	34, // 33: cresta.releaser.Releaser.ListDeployments:input_type -> cresta.releaser.ListDeploymentsRequest
+	37, // 34: cresta.releaser.Releaser.SetDeploymentStatus:input_type -> cresta.releaser.SetDeploymentStatusRequest
+	11, // 35: cresta.releaser.Releaser.ListApplications:input_type -> cresta.releaser.ListApplicationsRequest
+	13, // 36: cresta.releaser.Releaser.ListReleases:input_type -> cresta.releaser.ListReleasesRequest
+	16, // 37: cresta.releaser.Releaser.GetRelease:input_type -> cresta.releaser.GetReleaseRequest
+	18, // 38: cresta.releaser.Releaser.PreviewRelease:input_type -> cresta.releaser.PreviewReleaseRequest
+	20, // 39: cresta.releaser.Releaser.NeedsPromotion:input_type -> cresta.releaser.NeedsPromotionRequest
+	22, // 40: cresta.releaser.Releaser.ApprovePromotion:input_type -> cresta.releaser.ApprovePromotionRequest
+	24, // 41: cresta.releaser.Releaser.MergePromotion:input_type -> cresta.releaser.MergePromotionRequest
+	26, // 42: cresta.releaser.Releaser.StartPromotion:input_type -> cresta.releaser.StartPromotionRequest
+	28, // 43: cresta.releaser.Releaser.GetJob:input_type -> cresta.releaser.GetJobRequest
+	30, // 44: cresta.releaser.Releaser.ListJobs:input_type -> cresta.releaser.ListJobsRequest
+	49, // 45: cresta.releaser.Releaser.GetAllApplicationStatus:output_type -> cresta.releaser.GetAllApplicationStatusResponse
+	47, // 46: cresta.releaser.Releaser.PushPromotion:output_type -> cresta.releaser.PushPromotionResponse
+	45, // 47: cresta.releaser.Releaser.RefreshRepository:output_type -> cresta.releaser.RefreshRepositoryResponse
+	43, // 48: cresta.releaser.Releaser.EnableAutoMerge:output_type -> cresta.releaser.EnableAutoMergeResponse
+	40, // 49: cresta.releaser.Releaser.ReconcilePullRequests:output_type -> cresta.releaser.ReconcilePullRequestsResponse
+	35, // 50: cresta.releaser.Releaser.ListDeployments:output_type -> cresta.releaser.ListDeploymentsResponse
+	38, // 51: cresta.releaser.Releaser.SetDeploymentStatus:output_type -> cresta.releaser.SetDeploymentStatusResponse
+	12, // 52: cresta.releaser.Releaser.ListApplications:output_type -> cresta.releaser.ListApplicationsResponse
+	14, // 53: cresta.releaser.Releaser.ListReleases:output_type -> cresta.releaser.ListReleasesResponse
+	17, // 54: cresta.releaser.Releaser.GetRelease:output_type -> cresta.releaser.GetReleaseResponse
+	19, // 55: cresta.releaser.Releaser.PreviewRelease:output_type -> cresta.releaser.PreviewReleaseResponse
+	21, // 56: cresta.releaser.Releaser.NeedsPromotion:output_type -> cresta.releaser.NeedsPromotionResponse
+	23, // 57: cresta.releaser.Releaser.ApprovePromotion:output_type -> cresta.releaser.ApprovePromotionResponse
+	25, // 58: cresta.releaser.Releaser.MergePromotion:output_type -> cresta.releaser.MergePromotionResponse
+	27, // 59: cresta.releaser.Releaser.StartPromotion:output_type -> cresta.releaser.StartPromotionResponse
+	29, // 60: cresta.releaser.Releaser.GetJob:output_type -> cresta.releaser.GetJobResponse
+	31, // 61: cresta.releaser.Releaser.ListJobs:output_type -> cresta.releaser.ListJobsResponse
+	45, // [45:62] is the sub-list for method output_type
+	28, // [28:45] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_rpc_releaser_Releaser_proto_init() }
+func file_rpc_releaser_Releaser_proto_init() {
+	if File_rpc_releaser_Releaser_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_releaser_Releaser_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReleasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeedsPromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeedsPromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovePromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovePromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeploymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deployment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDeploymentStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDeploymentStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcilePullRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcilePullRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosedPullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableAutoMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableAutoMergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRepositoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRepositoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPromotionRequest); i {
 			case 0:
 				return &v.state
@@ -693,7 +4708,7 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPromotionResponse); i {
 			case 0:
 				return &v.state
@@ -705,7 +4720,7 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAllApplicationStatusRequest); i {
 			case 0:
 				return &v.state
@@ -717,7 +4732,7 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAllApplicationStatusResponse); i {
 			case 0:
 				return &v.state
@@ -729,7 +4744,19 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplicationStatus); i {
 			case 0:
 				return &v.state
@@ -741,7 +4768,7 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_releaser_Releaser_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_releaser_Releaser_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReleaseStatus); i {
 			case 0:
 				return &v.state
@@ -753,14 +4780,38 @@ func file_rpc_releaser_Releaser_proto_init() {
 				return nil
 			}
 		}
+		file_rpc_releaser_Releaser_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequestStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_releaser_Releaser_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_releaser_Releaser_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      11,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
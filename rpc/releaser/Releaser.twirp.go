@@ -52,6 +52,51 @@ type Releaser interface {
 	PushPromotion(context.Context, *PushPromotionRequest) (*PushPromotionResponse, error)
 
 	RefreshRepository(context.Context, *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error)
+
+	// EnableAutoMerge makes GitHub merge a pull request once its reviews and checks pass
+	EnableAutoMerge(context.Context, *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error)
+
+	// ReconcilePullRequests closes promotion pull requests that are no longer needed and deletes their branches
+	ReconcilePullRequests(context.Context, *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error)
+
+	// ListDeployments returns the most recent GitHub deployments of a release, newest first
+	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+
+	// SetDeploymentStatus reports on the progress of a GitHub deployment
+	SetDeploymentStatus(context.Context, *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error)
+
+	// ListApplications returns the applications of a repository
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+
+	// ListReleases returns the releases of an application, in the order they are promoted
+	ListReleases(context.Context, *ListReleasesRequest) (*ListReleasesResponse, error)
+
+	// GetRelease returns the files of a release
+	GetRelease(context.Context, *GetReleaseRequest) (*GetReleaseResponse, error)
+
+	// PreviewRelease returns what a release looks like before and after promoting into it
+	PreviewRelease(context.Context, *PreviewReleaseRequest) (*PreviewReleaseResponse, error)
+
+	// NeedsPromotion returns whether promoting into a release would change it
+	NeedsPromotion(context.Context, *NeedsPromotionRequest) (*NeedsPromotionResponse, error)
+
+	// ApprovePromotion approves a promotion pull request on behalf of a reviewer.  Fails with PermissionDenied if the
+	// reviewer opened the pull request or asked for the promotion.
+	ApprovePromotion(context.Context, *ApprovePromotionRequest) (*ApprovePromotionResponse, error)
+
+	// MergePromotion merges a promotion pull request.  Fails with FailedPrecondition, and a "reason" meta of not_open,
+	// conflicting, checks_failing or review_required, if the pull request cannot be merged.
+	MergePromotion(context.Context, *MergePromotionRequest) (*MergePromotionResponse, error)
+
+	// StartPromotion queues a promotion and returns right away.  A promotion of the same release that has not started
+	// yet is returned instead of queueing another one.  The promotion runs even if the client disconnects.
+	StartPromotion(context.Context, *StartPromotionRequest) (*StartPromotionResponse, error)
+
+	// GetJob returns the progress of a promotion started with StartPromotion
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+
+	// ListJobs returns recent promotion jobs, newest first
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 }
 
 // ========================
@@ -60,7 +105,7 @@ type Releaser interface {
 
 type releaserProtobufClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [17]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -88,10 +133,24 @@ func NewReleaserProtobufClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "cresta.releaser", "Releaser")
-	urls := [3]string{
+	urls := [17]string{
 		serviceURL + "GetAllApplicationStatus",
 		serviceURL + "PushPromotion",
 		serviceURL + "RefreshRepository",
+		serviceURL + "EnableAutoMerge",
+		serviceURL + "ReconcilePullRequests",
+		serviceURL + "ListDeployments",
+		serviceURL + "SetDeploymentStatus",
+		serviceURL + "ListApplications",
+		serviceURL + "ListReleases",
+		serviceURL + "GetRelease",
+		serviceURL + "PreviewRelease",
+		serviceURL + "NeedsPromotion",
+		serviceURL + "ApprovePromotion",
+		serviceURL + "MergePromotion",
+		serviceURL + "StartPromotion",
+		serviceURL + "GetJob",
+		serviceURL + "ListJobs",
 	}
 
 	return &releaserProtobufClient{
@@ -240,74 +299,210 @@ func (c *releaserProtobufClient) callRefreshRepository(ctx context.Context, in *
 	return out, nil
 }
 
-// ====================
-// Releaser JSON Client
-// ====================
+func (c *releaserProtobufClient) EnableAutoMerge(ctx context.Context, in *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "EnableAutoMerge")
+	caller := c.callEnableAutoMerge
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnableAutoMergeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnableAutoMergeRequest) when calling interceptor")
+					}
+					return c.callEnableAutoMerge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnableAutoMergeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnableAutoMergeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-type releaserJSONClient struct {
-	client      HTTPClient
-	urls        [3]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *releaserProtobufClient) callEnableAutoMerge(ctx context.Context, in *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+	out := new(EnableAutoMergeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-// NewReleaserJSONClient creates a JSON client that implements the Releaser interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewReleaserJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Releaser {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *releaserProtobufClient) ReconcilePullRequests(ctx context.Context, in *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcilePullRequests")
+	caller := c.callReconcilePullRequests
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcilePullRequestsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcilePullRequestsRequest) when calling interceptor")
+					}
+					return c.callReconcilePullRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcilePullRequestsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcilePullRequestsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+func (c *releaserProtobufClient) callReconcilePullRequests(ctx context.Context, in *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+	out := new(ReconcilePullRequestsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeployments")
+	caller := c.callListDeployments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeploymentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeploymentsRequest) when calling interceptor")
+					}
+					return c.callListDeployments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeploymentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeploymentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "cresta.releaser", "Releaser")
-	urls := [3]string{
-		serviceURL + "GetAllApplicationStatus",
-		serviceURL + "PushPromotion",
-		serviceURL + "RefreshRepository",
+func (c *releaserProtobufClient) callListDeployments(ctx context.Context, in *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	out := new(ListDeploymentsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &releaserJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) SetDeploymentStatus(ctx context.Context, in *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "SetDeploymentStatus")
+	caller := c.callSetDeploymentStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetDeploymentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetDeploymentStatusRequest) when calling interceptor")
+					}
+					return c.callSetDeploymentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetDeploymentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetDeploymentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (c *releaserJSONClient) GetAllApplicationStatus(ctx context.Context, in *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+func (c *releaserProtobufClient) callSetDeploymentStatus(ctx context.Context, in *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+	out := new(SetDeploymentStatusResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) ListApplications(ctx context.Context, in *ListApplicationsRequest) (*ListApplicationsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
 	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
-	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
-	caller := c.callGetAllApplicationStatus
+	ctx = ctxsetters.WithMethodName(ctx, "ListApplications")
+	caller := c.callListApplications
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+		caller = func(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					typedReq, ok := req.(*ListApplicationsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListApplicationsRequest) when calling interceptor")
 					}
-					return c.callGetAllApplicationStatus(ctx, typedReq)
+					return c.callListApplications(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				typedResp, ok := resp.(*ListApplicationsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListApplicationsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -317,9 +512,9 @@ func (c *releaserJSONClient) GetAllApplicationStatus(ctx context.Context, in *Ge
 	return caller(ctx, in)
 }
 
-func (c *releaserJSONClient) callGetAllApplicationStatus(ctx context.Context, in *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
-	out := new(GetAllApplicationStatusResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *releaserProtobufClient) callListApplications(ctx context.Context, in *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	out := new(ListApplicationsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -334,26 +529,26 @@ func (c *releaserJSONClient) callGetAllApplicationStatus(ctx context.Context, in
 	return out, nil
 }
 
-func (c *releaserJSONClient) PushPromotion(ctx context.Context, in *PushPromotionRequest) (*PushPromotionResponse, error) {
+func (c *releaserProtobufClient) ListReleases(ctx context.Context, in *ListReleasesRequest) (*ListReleasesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
 	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
-	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
-	caller := c.callPushPromotion
+	ctx = ctxsetters.WithMethodName(ctx, "ListReleases")
+	caller := c.callListReleases
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+		caller = func(ctx context.Context, req *ListReleasesRequest) (*ListReleasesResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PushPromotionRequest)
+					typedReq, ok := req.(*ListReleasesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListReleasesRequest) when calling interceptor")
 					}
-					return c.callPushPromotion(ctx, typedReq)
+					return c.callListReleases(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*PushPromotionResponse)
+				typedResp, ok := resp.(*ListReleasesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReleasesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -363,9 +558,9 @@ func (c *releaserJSONClient) PushPromotion(ctx context.Context, in *PushPromotio
 	return caller(ctx, in)
 }
 
-func (c *releaserJSONClient) callPushPromotion(ctx context.Context, in *PushPromotionRequest) (*PushPromotionResponse, error) {
-	out := new(PushPromotionResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *releaserProtobufClient) callListReleases(ctx context.Context, in *ListReleasesRequest) (*ListReleasesResponse, error) {
+	out := new(ListReleasesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -380,166 +575,3894 @@ func (c *releaserJSONClient) callPushPromotion(ctx context.Context, in *PushProm
 	return out, nil
 }
 
-func (c *releaserJSONClient) RefreshRepository(ctx context.Context, in *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+func (c *releaserProtobufClient) GetRelease(ctx context.Context, in *GetReleaseRequest) (*GetReleaseResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
 	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
-	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
-	caller := c.callRefreshRepository
+	ctx = ctxsetters.WithMethodName(ctx, "GetRelease")
+	caller := c.callGetRelease
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+		caller = func(ctx context.Context, req *GetReleaseRequest) (*GetReleaseResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RefreshRepositoryRequest)
+					typedReq, ok := req.(*GetReleaseRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetReleaseRequest) when calling interceptor")
 					}
-					return c.callRefreshRepository(ctx, typedReq)
+					return c.callGetRelease(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				typedResp, ok := resp.(*GetReleaseResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callGetRelease(ctx context.Context, in *GetReleaseRequest) (*GetReleaseResponse, error) {
+	out := new(GetReleaseResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) PreviewRelease(ctx context.Context, in *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewRelease")
+	caller := c.callPreviewRelease
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreviewReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreviewReleaseRequest) when calling interceptor")
+					}
+					return c.callPreviewRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreviewReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreviewReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callPreviewRelease(ctx context.Context, in *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+	out := new(PreviewReleaseResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) NeedsPromotion(ctx context.Context, in *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "NeedsPromotion")
+	caller := c.callNeedsPromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeedsPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeedsPromotionRequest) when calling interceptor")
+					}
+					return c.callNeedsPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeedsPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeedsPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callNeedsPromotion(ctx context.Context, in *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+	out := new(NeedsPromotionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) ApprovePromotion(ctx context.Context, in *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePromotion")
+	caller := c.callApprovePromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePromotionRequest) when calling interceptor")
+					}
+					return c.callApprovePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callApprovePromotion(ctx context.Context, in *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+	out := new(ApprovePromotionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) MergePromotion(ctx context.Context, in *MergePromotionRequest) (*MergePromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "MergePromotion")
+	caller := c.callMergePromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergePromotionRequest) (*MergePromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergePromotionRequest) when calling interceptor")
+					}
+					return c.callMergePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callMergePromotion(ctx context.Context, in *MergePromotionRequest) (*MergePromotionResponse, error) {
+	out := new(MergePromotionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) StartPromotion(ctx context.Context, in *StartPromotionRequest) (*StartPromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPromotion")
+	caller := c.callStartPromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartPromotionRequest) (*StartPromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPromotionRequest) when calling interceptor")
+					}
+					return c.callStartPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callStartPromotion(ctx context.Context, in *StartPromotionRequest) (*StartPromotionResponse, error) {
+	out := new(StartPromotionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) GetJob(ctx context.Context, in *GetJobRequest) (*GetJobResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJob")
+	caller := c.callGetJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetJobRequest) (*GetJobResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJobRequest) when calling interceptor")
+					}
+					return c.callGetJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJobResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJobResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callGetJob(ctx context.Context, in *GetJobRequest) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserProtobufClient) ListJobs(ctx context.Context, in *ListJobsRequest) (*ListJobsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
+	caller := c.callListJobs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListJobsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListJobsRequest) when calling interceptor")
+					}
+					return c.callListJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserProtobufClient) callListJobs(ctx context.Context, in *ListJobsRequest) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ====================
+// Releaser JSON Client
+// ====================
+
+type releaserJSONClient struct {
+	client      HTTPClient
+	urls        [17]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReleaserJSONClient creates a JSON client that implements the Releaser interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewReleaserJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Releaser {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "cresta.releaser", "Releaser")
+	urls := [17]string{
+		serviceURL + "GetAllApplicationStatus",
+		serviceURL + "PushPromotion",
+		serviceURL + "RefreshRepository",
+		serviceURL + "EnableAutoMerge",
+		serviceURL + "ReconcilePullRequests",
+		serviceURL + "ListDeployments",
+		serviceURL + "SetDeploymentStatus",
+		serviceURL + "ListApplications",
+		serviceURL + "ListReleases",
+		serviceURL + "GetRelease",
+		serviceURL + "PreviewRelease",
+		serviceURL + "NeedsPromotion",
+		serviceURL + "ApprovePromotion",
+		serviceURL + "MergePromotion",
+		serviceURL + "StartPromotion",
+		serviceURL + "GetJob",
+		serviceURL + "ListJobs",
+	}
+
+	return &releaserJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *releaserJSONClient) GetAllApplicationStatus(ctx context.Context, in *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
+	caller := c.callGetAllApplicationStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+					}
+					return c.callGetAllApplicationStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callGetAllApplicationStatus(ctx context.Context, in *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+	out := new(GetAllApplicationStatusResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) PushPromotion(ctx context.Context, in *PushPromotionRequest) (*PushPromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
+	caller := c.callPushPromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PushPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+					}
+					return c.callPushPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PushPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callPushPromotion(ctx context.Context, in *PushPromotionRequest) (*PushPromotionResponse, error) {
+	out := new(PushPromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) RefreshRepository(ctx context.Context, in *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
+	caller := c.callRefreshRepository
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshRepositoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+					}
+					return c.callRefreshRepository(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callRefreshRepository(ctx context.Context, in *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+	out := new(RefreshRepositoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) EnableAutoMerge(ctx context.Context, in *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "EnableAutoMerge")
+	caller := c.callEnableAutoMerge
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnableAutoMergeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnableAutoMergeRequest) when calling interceptor")
+					}
+					return c.callEnableAutoMerge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnableAutoMergeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnableAutoMergeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callEnableAutoMerge(ctx context.Context, in *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+	out := new(EnableAutoMergeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ReconcilePullRequests(ctx context.Context, in *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcilePullRequests")
+	caller := c.callReconcilePullRequests
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcilePullRequestsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcilePullRequestsRequest) when calling interceptor")
+					}
+					return c.callReconcilePullRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcilePullRequestsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcilePullRequestsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callReconcilePullRequests(ctx context.Context, in *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+	out := new(ReconcilePullRequestsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeployments")
+	caller := c.callListDeployments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeploymentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeploymentsRequest) when calling interceptor")
+					}
+					return c.callListDeployments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeploymentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeploymentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callListDeployments(ctx context.Context, in *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	out := new(ListDeploymentsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) SetDeploymentStatus(ctx context.Context, in *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "SetDeploymentStatus")
+	caller := c.callSetDeploymentStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetDeploymentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetDeploymentStatusRequest) when calling interceptor")
+					}
+					return c.callSetDeploymentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetDeploymentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetDeploymentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callSetDeploymentStatus(ctx context.Context, in *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+	out := new(SetDeploymentStatusResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ListApplications(ctx context.Context, in *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListApplications")
+	caller := c.callListApplications
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListApplicationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListApplicationsRequest) when calling interceptor")
+					}
+					return c.callListApplications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListApplicationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListApplicationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callListApplications(ctx context.Context, in *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	out := new(ListApplicationsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ListReleases(ctx context.Context, in *ListReleasesRequest) (*ListReleasesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListReleases")
+	caller := c.callListReleases
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListReleasesRequest) (*ListReleasesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReleasesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReleasesRequest) when calling interceptor")
+					}
+					return c.callListReleases(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReleasesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReleasesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callListReleases(ctx context.Context, in *ListReleasesRequest) (*ListReleasesResponse, error) {
+	out := new(ListReleasesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) GetRelease(ctx context.Context, in *GetReleaseRequest) (*GetReleaseResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRelease")
+	caller := c.callGetRelease
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetReleaseRequest) (*GetReleaseResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReleaseRequest) when calling interceptor")
+					}
+					return c.callGetRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callGetRelease(ctx context.Context, in *GetReleaseRequest) (*GetReleaseResponse, error) {
+	out := new(GetReleaseResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) PreviewRelease(ctx context.Context, in *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewRelease")
+	caller := c.callPreviewRelease
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreviewReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreviewReleaseRequest) when calling interceptor")
+					}
+					return c.callPreviewRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreviewReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreviewReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callPreviewRelease(ctx context.Context, in *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+	out := new(PreviewReleaseResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) NeedsPromotion(ctx context.Context, in *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "NeedsPromotion")
+	caller := c.callNeedsPromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeedsPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeedsPromotionRequest) when calling interceptor")
+					}
+					return c.callNeedsPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeedsPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeedsPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callNeedsPromotion(ctx context.Context, in *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+	out := new(NeedsPromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ApprovePromotion(ctx context.Context, in *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePromotion")
+	caller := c.callApprovePromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePromotionRequest) when calling interceptor")
+					}
+					return c.callApprovePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callApprovePromotion(ctx context.Context, in *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+	out := new(ApprovePromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) MergePromotion(ctx context.Context, in *MergePromotionRequest) (*MergePromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "MergePromotion")
+	caller := c.callMergePromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergePromotionRequest) (*MergePromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergePromotionRequest) when calling interceptor")
+					}
+					return c.callMergePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callMergePromotion(ctx context.Context, in *MergePromotionRequest) (*MergePromotionResponse, error) {
+	out := new(MergePromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) StartPromotion(ctx context.Context, in *StartPromotionRequest) (*StartPromotionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPromotion")
+	caller := c.callStartPromotion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartPromotionRequest) (*StartPromotionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPromotionRequest) when calling interceptor")
+					}
+					return c.callStartPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callStartPromotion(ctx context.Context, in *StartPromotionRequest) (*StartPromotionResponse, error) {
+	out := new(StartPromotionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) GetJob(ctx context.Context, in *GetJobRequest) (*GetJobResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJob")
+	caller := c.callGetJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetJobRequest) (*GetJobResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetJobRequest) when calling interceptor")
+					}
+					return c.callGetJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetJobResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJobResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callGetJob(ctx context.Context, in *GetJobRequest) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *releaserJSONClient) ListJobs(ctx context.Context, in *ListJobsRequest) (*ListJobsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
+	caller := c.callListJobs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListJobsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListJobsRequest) when calling interceptor")
+					}
+					return c.callListJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *releaserJSONClient) callListJobs(ctx context.Context, in *ListJobsRequest) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// Releaser Server Handler
+// =======================
+
+type releaserServer struct {
+	Releaser
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewReleaserServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewReleaserServer(svc Releaser, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &releaserServer{
+		Releaser:         svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *releaserServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *releaserServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ReleaserPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ReleaserPathPrefix = "/twirp/cresta.releaser.Releaser/"
+
+func (s *releaserServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
+	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "cresta.releaser.Releaser" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetAllApplicationStatus":
+		s.serveGetAllApplicationStatus(ctx, resp, req)
+		return
+	case "PushPromotion":
+		s.servePushPromotion(ctx, resp, req)
+		return
+	case "RefreshRepository":
+		s.serveRefreshRepository(ctx, resp, req)
+		return
+	case "EnableAutoMerge":
+		s.serveEnableAutoMerge(ctx, resp, req)
+		return
+	case "ReconcilePullRequests":
+		s.serveReconcilePullRequests(ctx, resp, req)
+		return
+	case "ListDeployments":
+		s.serveListDeployments(ctx, resp, req)
+		return
+	case "SetDeploymentStatus":
+		s.serveSetDeploymentStatus(ctx, resp, req)
+		return
+	case "ListApplications":
+		s.serveListApplications(ctx, resp, req)
+		return
+	case "ListReleases":
+		s.serveListReleases(ctx, resp, req)
+		return
+	case "GetRelease":
+		s.serveGetRelease(ctx, resp, req)
+		return
+	case "PreviewRelease":
+		s.servePreviewRelease(ctx, resp, req)
+		return
+	case "NeedsPromotion":
+		s.serveNeedsPromotion(ctx, resp, req)
+		return
+	case "ApprovePromotion":
+		s.serveApprovePromotion(ctx, resp, req)
+		return
+	case "MergePromotion":
+		s.serveMergePromotion(ctx, resp, req)
+		return
+	case "StartPromotion":
+		s.serveStartPromotion(ctx, resp, req)
+		return
+	case "GetJob":
+		s.serveGetJob(ctx, resp, req)
+		return
+	case "ListJobs":
+		s.serveListJobs(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *releaserServer) serveGetAllApplicationStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAllApplicationStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAllApplicationStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetAllApplicationStatusRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.GetAllApplicationStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+					}
+					return s.Releaser.GetAllApplicationStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAllApplicationStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllApplicationStatusResponse and nil error while calling GetAllApplicationStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetAllApplicationStatusRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.GetAllApplicationStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+					}
+					return s.Releaser.GetAllApplicationStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAllApplicationStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllApplicationStatusResponse and nil error while calling GetAllApplicationStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) servePushPromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePushPromotionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePushPromotionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PushPromotionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.PushPromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PushPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.PushPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PushPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PushPromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PushPromotionResponse and nil error while calling PushPromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PushPromotionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.PushPromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PushPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.PushPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PushPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PushPromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PushPromotionResponse and nil error while calling PushPromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveRefreshRepository(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRefreshRepositoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRefreshRepositoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RefreshRepositoryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.RefreshRepository
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshRepositoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+					}
+					return s.Releaser.RefreshRepository(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefreshRepositoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshRepositoryResponse and nil error while calling RefreshRepository. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveRefreshRepositoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RefreshRepositoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.RefreshRepository
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RefreshRepositoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+					}
+					return s.Releaser.RefreshRepository(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RefreshRepositoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshRepositoryResponse and nil error while calling RefreshRepository. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveEnableAutoMerge(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveEnableAutoMergeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveEnableAutoMergeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveEnableAutoMergeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EnableAutoMerge")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(EnableAutoMergeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.EnableAutoMerge
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnableAutoMergeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnableAutoMergeRequest) when calling interceptor")
+					}
+					return s.Releaser.EnableAutoMerge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnableAutoMergeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnableAutoMergeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EnableAutoMergeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EnableAutoMergeResponse and nil error while calling EnableAutoMerge. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveEnableAutoMergeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EnableAutoMerge")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(EnableAutoMergeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.EnableAutoMerge
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EnableAutoMergeRequest) (*EnableAutoMergeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EnableAutoMergeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EnableAutoMergeRequest) when calling interceptor")
+					}
+					return s.Releaser.EnableAutoMerge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EnableAutoMergeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EnableAutoMergeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EnableAutoMergeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EnableAutoMergeResponse and nil error while calling EnableAutoMerge. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveReconcilePullRequests(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReconcilePullRequestsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReconcilePullRequestsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveReconcilePullRequestsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcilePullRequests")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReconcilePullRequestsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.ReconcilePullRequests
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcilePullRequestsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcilePullRequestsRequest) when calling interceptor")
+					}
+					return s.Releaser.ReconcilePullRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcilePullRequestsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcilePullRequestsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReconcilePullRequestsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReconcilePullRequestsResponse and nil error while calling ReconcilePullRequests. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveReconcilePullRequestsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcilePullRequests")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReconcilePullRequestsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.ReconcilePullRequests
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReconcilePullRequestsRequest) (*ReconcilePullRequestsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcilePullRequestsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcilePullRequestsRequest) when calling interceptor")
+					}
+					return s.Releaser.ReconcilePullRequests(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcilePullRequestsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcilePullRequestsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReconcilePullRequestsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReconcilePullRequestsResponse and nil error while calling ReconcilePullRequests. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListDeployments(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListDeploymentsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListDeploymentsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveListDeploymentsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeployments")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListDeploymentsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.ListDeployments
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeploymentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeploymentsRequest) when calling interceptor")
+					}
+					return s.Releaser.ListDeployments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeploymentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeploymentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDeploymentsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDeploymentsResponse and nil error while calling ListDeployments. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListDeploymentsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeployments")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListDeploymentsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.ListDeployments
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeploymentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeploymentsRequest) when calling interceptor")
+					}
+					return s.Releaser.ListDeployments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeploymentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeploymentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDeploymentsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDeploymentsResponse and nil error while calling ListDeployments. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveSetDeploymentStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetDeploymentStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetDeploymentStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveSetDeploymentStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetDeploymentStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetDeploymentStatusRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.SetDeploymentStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetDeploymentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetDeploymentStatusRequest) when calling interceptor")
+					}
+					return s.Releaser.SetDeploymentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetDeploymentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetDeploymentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetDeploymentStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetDeploymentStatusResponse and nil error while calling SetDeploymentStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveSetDeploymentStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetDeploymentStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetDeploymentStatusRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.SetDeploymentStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetDeploymentStatusRequest) (*SetDeploymentStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetDeploymentStatusRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetDeploymentStatusRequest) when calling interceptor")
+					}
+					return s.Releaser.SetDeploymentStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetDeploymentStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetDeploymentStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetDeploymentStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetDeploymentStatusResponse and nil error while calling SetDeploymentStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListApplications(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListApplicationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListApplicationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveListApplicationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListApplications")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListApplicationsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.ListApplications
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListApplicationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListApplicationsRequest) when calling interceptor")
+					}
+					return s.Releaser.ListApplications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListApplicationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListApplicationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListApplicationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListApplicationsResponse and nil error while calling ListApplications. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListApplicationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListApplications")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListApplicationsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.ListApplications
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListApplicationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListApplicationsRequest) when calling interceptor")
+					}
+					return s.Releaser.ListApplications(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListApplicationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListApplicationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListApplicationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListApplicationsResponse and nil error while calling ListApplications. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListReleases(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListReleasesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListReleasesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveListReleasesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListReleases")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListReleasesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.ListReleases
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListReleasesRequest) (*ListReleasesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReleasesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReleasesRequest) when calling interceptor")
+					}
+					return s.Releaser.ListReleases(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReleasesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReleasesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListReleasesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListReleasesResponse and nil error while calling ListReleases. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveListReleasesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListReleases")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListReleasesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.ListReleases
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListReleasesRequest) (*ListReleasesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListReleasesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListReleasesRequest) when calling interceptor")
+					}
+					return s.Releaser.ListReleases(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListReleasesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListReleasesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListReleasesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListReleasesResponse and nil error while calling ListReleases. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveGetRelease(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetReleaseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetReleaseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveGetReleaseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRelease")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetReleaseRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.GetRelease
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetReleaseRequest) (*GetReleaseResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReleaseRequest) when calling interceptor")
+					}
+					return s.Releaser.GetRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetReleaseResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReleaseResponse and nil error while calling GetRelease. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveGetReleaseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRelease")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetReleaseRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.GetRelease
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetReleaseRequest) (*GetReleaseResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReleaseRequest) when calling interceptor")
+					}
+					return s.Releaser.GetRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetReleaseResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReleaseResponse and nil error while calling GetRelease. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) servePreviewRelease(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePreviewReleaseJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePreviewReleaseProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) servePreviewReleaseJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewRelease")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PreviewReleaseRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.PreviewRelease
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreviewReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreviewReleaseRequest) when calling interceptor")
+					}
+					return s.Releaser.PreviewRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreviewReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreviewReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PreviewReleaseResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PreviewReleaseResponse and nil error while calling PreviewRelease. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) servePreviewReleaseProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PreviewRelease")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PreviewReleaseRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.PreviewRelease
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PreviewReleaseRequest) (*PreviewReleaseResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PreviewReleaseRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PreviewReleaseRequest) when calling interceptor")
+					}
+					return s.Releaser.PreviewRelease(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PreviewReleaseResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PreviewReleaseResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PreviewReleaseResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PreviewReleaseResponse and nil error while calling PreviewRelease. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveNeedsPromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNeedsPromotionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNeedsPromotionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveNeedsPromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NeedsPromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(NeedsPromotionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.NeedsPromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeedsPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeedsPromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.NeedsPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeedsPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeedsPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NeedsPromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NeedsPromotionResponse and nil error while calling NeedsPromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveNeedsPromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NeedsPromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(NeedsPromotionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.NeedsPromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NeedsPromotionRequest) (*NeedsPromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeedsPromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeedsPromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.NeedsPromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeedsPromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeedsPromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NeedsPromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NeedsPromotionResponse and nil error while calling NeedsPromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveApprovePromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveApprovePromotionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveApprovePromotionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveApprovePromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ApprovePromotionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.ApprovePromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.ApprovePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApprovePromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApprovePromotionResponse and nil error while calling ApprovePromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveApprovePromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApprovePromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ApprovePromotionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Releaser.ApprovePromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApprovePromotionRequest) (*ApprovePromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApprovePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApprovePromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.ApprovePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApprovePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApprovePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApprovePromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApprovePromotionResponse and nil error while calling ApprovePromotion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *releaserServer) serveMergePromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMergePromotionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMergePromotionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *releaserServer) serveMergePromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergePromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MergePromotionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Releaser.MergePromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MergePromotionRequest) (*MergePromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergePromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.MergePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergePromotionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *releaserJSONClient) callRefreshRepository(ctx context.Context, in *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
-	out := new(RefreshRepositoryResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	// Call service method
+	var respContent *MergePromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MergePromotionResponse and nil error while calling MergePromotion. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// =======================
-// Releaser Server Handler
-// =======================
-
-type releaserServer struct {
-	Releaser
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewReleaserServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewReleaserServer(svc Releaser, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &releaserServer{
-		Releaser:         svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *releaserServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *releaserServer) serveMergePromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MergePromotion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *releaserServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(MergePromotionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// ReleaserPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const ReleaserPathPrefix = "/twirp/cresta.releaser.Releaser/"
+	handler := s.Releaser.MergePromotion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MergePromotionRequest) (*MergePromotionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergePromotionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergePromotionRequest) when calling interceptor")
+					}
+					return s.Releaser.MergePromotion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MergePromotionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MergePromotionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *releaserServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "cresta.releaser")
-	ctx = ctxsetters.WithServiceName(ctx, "Releaser")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *MergePromotionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MergePromotionResponse and nil error while calling MergePromotion. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "cresta.releaser.Releaser" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "GetAllApplicationStatus":
-		s.serveGetAllApplicationStatus(ctx, resp, req)
-		return
-	case "PushPromotion":
-		s.servePushPromotion(ctx, resp, req)
-		return
-	case "RefreshRepository":
-		s.serveRefreshRepository(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) serveGetAllApplicationStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveStartPromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -547,9 +4470,9 @@ func (s *releaserServer) serveGetAllApplicationStatus(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetAllApplicationStatusJSON(ctx, resp, req)
+		s.serveStartPromotionJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetAllApplicationStatusProtobuf(ctx, resp, req)
+		s.serveStartPromotionProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -557,9 +4480,9 @@ func (s *releaserServer) serveGetAllApplicationStatus(ctx context.Context, resp
 	}
 }
 
-func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveStartPromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPromotion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -572,29 +4495,29 @@ func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetAllApplicationStatusRequest)
+	reqContent := new(StartPromotionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Releaser.GetAllApplicationStatus
+	handler := s.Releaser.StartPromotion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+		handler = func(ctx context.Context, req *StartPromotionRequest) (*StartPromotionResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					typedReq, ok := req.(*StartPromotionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*StartPromotionRequest) when calling interceptor")
 					}
-					return s.Releaser.GetAllApplicationStatus(ctx, typedReq)
+					return s.Releaser.StartPromotion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				typedResp, ok := resp.(*StartPromotionResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPromotionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -603,7 +4526,7 @@ func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *GetAllApplicationStatusResponse
+	var respContent *StartPromotionResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -614,7 +4537,7 @@ func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllApplicationStatusResponse and nil error while calling GetAllApplicationStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartPromotionResponse and nil error while calling StartPromotion. nil responses are not supported"))
 		return
 	}
 
@@ -640,9 +4563,9 @@ func (s *releaserServer) serveGetAllApplicationStatusJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveStartPromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetAllApplicationStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPromotion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -654,28 +4577,28 @@ func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetAllApplicationStatusRequest)
+	reqContent := new(StartPromotionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Releaser.GetAllApplicationStatus
+	handler := s.Releaser.StartPromotion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetAllApplicationStatusRequest) (*GetAllApplicationStatusResponse, error) {
+		handler = func(ctx context.Context, req *StartPromotionRequest) (*StartPromotionResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAllApplicationStatusRequest)
+					typedReq, ok := req.(*StartPromotionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAllApplicationStatusRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*StartPromotionRequest) when calling interceptor")
 					}
-					return s.Releaser.GetAllApplicationStatus(ctx, typedReq)
+					return s.Releaser.StartPromotion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAllApplicationStatusResponse)
+				typedResp, ok := resp.(*StartPromotionResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAllApplicationStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPromotionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -684,7 +4607,7 @@ func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *GetAllApplicationStatusResponse
+	var respContent *StartPromotionResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -695,7 +4618,7 @@ func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAllApplicationStatusResponse and nil error while calling GetAllApplicationStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartPromotionResponse and nil error while calling StartPromotion. nil responses are not supported"))
 		return
 	}
 
@@ -719,7 +4642,7 @@ func (s *releaserServer) serveGetAllApplicationStatusProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) servePushPromotion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveGetJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -727,9 +4650,9 @@ func (s *releaserServer) servePushPromotion(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.servePushPromotionJSON(ctx, resp, req)
+		s.serveGetJobJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.servePushPromotionProtobuf(ctx, resp, req)
+		s.serveGetJobProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -737,9 +4660,9 @@ func (s *releaserServer) servePushPromotion(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveGetJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -752,29 +4675,29 @@ func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(PushPromotionRequest)
+	reqContent := new(GetJobRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Releaser.PushPromotion
+	handler := s.Releaser.GetJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+		handler = func(ctx context.Context, req *GetJobRequest) (*GetJobResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PushPromotionRequest)
+					typedReq, ok := req.(*GetJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetJobRequest) when calling interceptor")
 					}
-					return s.Releaser.PushPromotion(ctx, typedReq)
+					return s.Releaser.GetJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*PushPromotionResponse)
+				typedResp, ok := resp.(*GetJobResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJobResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -783,7 +4706,7 @@ func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *PushPromotionResponse
+	var respContent *GetJobResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -794,7 +4717,7 @@ func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *PushPromotionResponse and nil error while calling PushPromotion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetJobResponse and nil error while calling GetJob. nil responses are not supported"))
 		return
 	}
 
@@ -820,9 +4743,9 @@ func (s *releaserServer) servePushPromotionJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveGetJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PushPromotion")
+	ctx = ctxsetters.WithMethodName(ctx, "GetJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -834,28 +4757,28 @@ func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(PushPromotionRequest)
+	reqContent := new(GetJobRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Releaser.PushPromotion
+	handler := s.Releaser.GetJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PushPromotionRequest) (*PushPromotionResponse, error) {
+		handler = func(ctx context.Context, req *GetJobRequest) (*GetJobResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PushPromotionRequest)
+					typedReq, ok := req.(*GetJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PushPromotionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetJobRequest) when calling interceptor")
 					}
-					return s.Releaser.PushPromotion(ctx, typedReq)
+					return s.Releaser.GetJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*PushPromotionResponse)
+				typedResp, ok := resp.(*GetJobResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*PushPromotionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetJobResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -864,7 +4787,7 @@ func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *PushPromotionResponse
+	var respContent *GetJobResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -875,7 +4798,7 @@ func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *PushPromotionResponse and nil error while calling PushPromotion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetJobResponse and nil error while calling GetJob. nil responses are not supported"))
 		return
 	}
 
@@ -899,7 +4822,7 @@ func (s *releaserServer) servePushPromotionProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) serveRefreshRepository(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveListJobs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -907,9 +4830,9 @@ func (s *releaserServer) serveRefreshRepository(ctx context.Context, resp http.R
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveRefreshRepositoryJSON(ctx, resp, req)
+		s.serveListJobsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveRefreshRepositoryProtobuf(ctx, resp, req)
+		s.serveListJobsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -917,9 +4840,9 @@ func (s *releaserServer) serveRefreshRepository(ctx context.Context, resp http.R
 	}
 }
 
-func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveListJobsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -932,29 +4855,29 @@ func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(RefreshRepositoryRequest)
+	reqContent := new(ListJobsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Releaser.RefreshRepository
+	handler := s.Releaser.ListJobs
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+		handler = func(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RefreshRepositoryRequest)
+					typedReq, ok := req.(*ListJobsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListJobsRequest) when calling interceptor")
 					}
-					return s.Releaser.RefreshRepository(ctx, typedReq)
+					return s.Releaser.ListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -963,7 +4886,7 @@ func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *RefreshRepositoryResponse
+	var respContent *ListJobsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -974,7 +4897,7 @@ func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshRepositoryResponse and nil error while calling RefreshRepository. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListJobsResponse and nil error while calling ListJobs. nil responses are not supported"))
 		return
 	}
 
@@ -1000,9 +4923,9 @@ func (s *releaserServer) serveRefreshRepositoryJSON(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *releaserServer) serveRefreshRepositoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *releaserServer) serveListJobsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "RefreshRepository")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1014,28 +4937,28 @@ func (s *releaserServer) serveRefreshRepositoryProtobuf(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(RefreshRepositoryRequest)
+	reqContent := new(ListJobsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Releaser.RefreshRepository
+	handler := s.Releaser.ListJobs
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *RefreshRepositoryRequest) (*RefreshRepositoryResponse, error) {
+		handler = func(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*RefreshRepositoryRequest)
+					typedReq, ok := req.(*ListJobsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*RefreshRepositoryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListJobsRequest) when calling interceptor")
 					}
-					return s.Releaser.RefreshRepository(ctx, typedReq)
+					return s.Releaser.ListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*RefreshRepositoryResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*RefreshRepositoryResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1044,7 +4967,7 @@ func (s *releaserServer) serveRefreshRepositoryProtobuf(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *RefreshRepositoryResponse
+	var respContent *ListJobsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1055,7 +4978,7 @@ func (s *releaserServer) serveRefreshRepositoryProtobuf(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *RefreshRepositoryResponse and nil error while calling RefreshRepository. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListJobsResponse and nil error while calling ListJobs. nil responses are not supported"))
 		return
 	}
 
@@ -1657,40 +5580,169 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0xad, 0x4d, 0x94, 0x90, 0x21, 0x80, 0x59, 0x25, 0x2a, 0x01, 0x29, 0x4d, 0x2d, 0x25, 0x22,
-	0x95, 0x62, 0x10, 0x3d, 0xf7, 0x40, 0x1b, 0x8b, 0xa2, 0x22, 0x87, 0xd8, 0xa1, 0xa9, 0x7a, 0xa8,
-	0xb5, 0xc0, 0x16, 0x5c, 0x19, 0xdb, 0xdd, 0x5d, 0x1f, 0x2a, 0xf5, 0x33, 0x7b, 0xab, 0xd4, 0x6f,
-	0xa9, 0x58, 0x2f, 0x14, 0xb0, 0x93, 0x72, 0xb2, 0x3d, 0x7e, 0x6f, 0xdf, 0xec, 0xbc, 0x99, 0x81,
-	0x3a, 0x8d, 0xc6, 0x4d, 0x4a, 0x7c, 0x82, 0x19, 0xa1, 0x4d, 0x5b, 0xbe, 0x18, 0x11, 0x0d, 0x79,
-	0x88, 0xca, 0x63, 0x4a, 0x18, 0xc7, 0xc6, 0xf2, 0xbf, 0x5e, 0x83, 0xaa, 0x4d, 0xbe, 0x52, 0xc2,
-	0x66, 0x36, 0x89, 0x42, 0xe6, 0xf1, 0x90, 0xfe, 0xb0, 0xc9, 0xf7, 0x98, 0x30, 0xae, 0xd7, 0xe1,
-	0x34, 0xe3, 0x1f, 0x8b, 0xc2, 0x80, 0x11, 0x7d, 0x02, 0xc7, 0x83, 0x98, 0xcd, 0x06, 0x34, 0x9c,
-	0x87, 0xdc, 0x0b, 0x03, 0x49, 0x42, 0x57, 0xa0, 0xe1, 0x28, 0xf2, 0xbd, 0x31, 0x5e, 0x44, 0xdd,
-	0x00, 0xcf, 0x49, 0x55, 0x39, 0x57, 0x1a, 0x87, 0x76, 0x79, 0x2d, 0x6e, 0xe1, 0x39, 0x41, 0x2f,
-	0xe1, 0x48, 0xe6, 0x91, 0xc0, 0x54, 0x01, 0x2b, 0xc8, 0xd8, 0x02, 0xa2, 0xff, 0x51, 0xe0, 0x64,
-	0x4b, 0x26, 0xd1, 0x47, 0x26, 0xec, 0x33, 0x8e, 0x79, 0xcc, 0xc4, 0xe9, 0xa5, 0xf6, 0xb5, 0xb1,
-	0x75, 0x35, 0x23, 0x93, 0x67, 0x38, 0x82, 0x64, 0x4b, 0x32, 0xba, 0x84, 0x72, 0x14, 0xfb, 0xbe,
-	0x4b, 0x93, 0xf4, 0x5d, 0x6f, 0x22, 0xd2, 0xc8, 0xd9, 0xc5, 0x45, 0x58, 0x5e, 0xaa, 0x37, 0xd1,
-	0x3f, 0xc2, 0x7e, 0xc2, 0x44, 0x05, 0x38, 0x18, 0x5a, 0x1f, 0xac, 0xdb, 0x07, 0x4b, 0x7b, 0x86,
-	0x4e, 0xe1, 0xc4, 0xfc, 0xd4, 0x73, 0xee, 0x7b, 0x56, 0xd7, 0x1d, 0x0c, 0xfb, 0x7d, 0xd7, 0x36,
-	0xef, 0x86, 0xa6, 0x73, 0xaf, 0x29, 0xe8, 0x18, 0x34, 0xcb, 0x7c, 0xd8, 0x8c, 0xaa, 0xa8, 0x04,
-	0x60, 0xdd, 0xba, 0xef, 0xde, 0x77, 0xac, 0xae, 0xe9, 0x68, 0x39, 0xfd, 0x1c, 0xce, 0xba, 0x84,
-	0x77, 0x7c, 0xbf, 0xf3, 0xaf, 0x38, 0x32, 0x45, 0xe9, 0x02, 0x87, 0x17, 0x8f, 0x22, 0x64, 0x2d,
-	0xee, 0x00, 0xad, 0xd7, 0x7c, 0x55, 0x97, 0x5c, 0xa3, 0xd0, 0xd6, 0x53, 0x75, 0x49, 0x9f, 0x53,
-	0xc1, 0xdb, 0x21, 0x3d, 0x80, 0x4a, 0x0a, 0x87, 0x10, 0xec, 0xad, 0xf9, 0x29, 0xde, 0x91, 0x09,
-	0xa5, 0xa5, 0x89, 0x52, 0x57, 0x15, 0xba, 0x67, 0x29, 0x5d, 0xd9, 0x8a, 0x52, 0xb3, 0x48, 0xd7,
-	0x3f, 0xf5, 0xdf, 0x0a, 0x14, 0x37, 0x00, 0x99, 0x62, 0x6f, 0x56, 0xa6, 0xab, 0xc2, 0xf4, 0x8b,
-	0xa7, 0x45, 0xb6, 0xcd, 0xae, 0xc3, 0x61, 0x44, 0xdd, 0x20, 0x9e, 0x8f, 0x08, 0xad, 0xe6, 0x84,
-	0xcd, 0xf9, 0x88, 0x5a, 0xe2, 0x1b, 0x35, 0x40, 0x0b, 0xa9, 0x37, 0xf5, 0x02, 0xec, 0xbb, 0x53,
-	0x8f, 0xbb, 0x6c, 0x86, 0xab, 0x7b, 0x42, 0xbb, 0xb4, 0x8c, 0x77, 0x3d, 0xee, 0xcc, 0xb0, 0xde,
-	0xca, 0xee, 0x85, 0x02, 0x1c, 0x0c, 0x4c, 0xeb, 0xa6, 0x67, 0x75, 0x35, 0x05, 0x1d, 0x41, 0xde,
-	0x36, 0xfb, 0x66, 0xc7, 0x31, 0x6f, 0x34, 0xb5, 0xfd, 0x4b, 0x85, 0xfc, 0x72, 0x12, 0xd1, 0x4f,
-	0x78, 0xfe, 0x88, 0xa1, 0xa8, 0x99, 0xba, 0xcf, 0xd3, 0xcd, 0x51, 0x6b, 0xed, 0x4e, 0x90, 0xbd,
-	0xf2, 0x05, 0x8a, 0x1b, 0x83, 0x81, 0x2e, 0xfe, 0x37, 0x38, 0x89, 0xd2, 0xe5, 0x6e, 0xf3, 0x85,
-	0xbe, 0x41, 0x25, 0xb5, 0x34, 0xd0, 0x55, 0x86, 0x4f, 0xd9, 0x4b, 0xa7, 0xf6, 0x6a, 0x17, 0x68,
-	0xa2, 0xf5, 0xb6, 0xf5, 0xd9, 0x98, 0x7a, 0x7c, 0x16, 0x8f, 0x8c, 0x71, 0x38, 0x6f, 0x26, 0x3c,
-	0xf9, 0xb8, 0x5e, 0x6d, 0xc0, 0xf5, 0x75, 0x38, 0xda, 0x17, 0x6b, 0xf0, 0xf5, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0x2d, 0x20, 0x0d, 0x25, 0x05, 0x00, 0x00,
+	// 2616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x5b, 0x8f, 0x1b, 0x49,
+	0xd5, 0x69, 0xdf, 0xc6, 0x3e, 0x9e, 0xf1, 0xf4, 0x54, 0xe6, 0xe2, 0x38, 0xb7, 0x49, 0xe7, 0x4b,
+	0x66, 0xb2, 0xbb, 0x71, 0xf2, 0xcd, 0x82, 0x96, 0xac, 0xc8, 0x6a, 0x1d, 0xbb, 0x33, 0x71, 0x76,
+	0xc6, 0x76, 0xca, 0xf6, 0x64, 0x37, 0x48, 0xf4, 0xfa, 0x52, 0x33, 0xd3, 0xc1, 0x76, 0x9b, 0xee,
+	0x76, 0xa2, 0x91, 0x78, 0x41, 0x08, 0x84, 0x84, 0xc4, 0x13, 0x48, 0xbc, 0xc2, 0x2b, 0xf0, 0x04,
+	0x0f, 0x48, 0xf0, 0x2f, 0x40, 0xf0, 0x07, 0xe0, 0x85, 0x5f, 0x81, 0xea, 0xd2, 0xee, 0x6e, 0x77,
+	0x7b, 0xdc, 0x41, 0x91, 0x46, 0x3c, 0xd9, 0x75, 0x6e, 0x75, 0xce, 0xa9, 0x73, 0x4e, 0x9d, 0xaa,
+	0x6a, 0xb8, 0x6a, 0x8e, 0x7b, 0x0f, 0x4c, 0x32, 0x20, 0x1d, 0x8b, 0x98, 0x0f, 0xb0, 0xf8, 0x53,
+	0x1c, 0x9b, 0x86, 0x6d, 0xa0, 0xd5, 0x9e, 0x49, 0x2c, 0xbb, 0x53, 0x74, 0xf0, 0xca, 0x23, 0xd8,
+	0x3a, 0xd0, 0x2d, 0xbb, 0x34, 0x1e, 0x0f, 0xf4, 0x5e, 0xc7, 0xd6, 0x8d, 0x91, 0x85, 0xc9, 0xf7,
+	0x27, 0xc4, 0xb2, 0xd1, 0x0d, 0x00, 0x93, 0x8c, 0x0d, 0x4b, 0xb7, 0x0d, 0xf3, 0x2c, 0x2f, 0x6d,
+	0x4b, 0xbb, 0x19, 0xec, 0x81, 0x28, 0x5f, 0x43, 0x3e, 0xc8, 0x6a, 0x8d, 0x8d, 0x91, 0x45, 0xd0,
+	0x87, 0xb0, 0xd6, 0x71, 0xe1, 0xda, 0xa8, 0x33, 0x24, 0x56, 0x5e, 0xda, 0x8e, 0xef, 0x66, 0xb0,
+	0xec, 0x41, 0xd4, 0x28, 0x1c, 0x6d, 0xc1, 0xd2, 0x89, 0x6e, 0x6b, 0xd6, 0x69, 0x27, 0x1f, 0x63,
+	0xb3, 0xa4, 0x4e, 0x74, 0xbb, 0x79, 0xda, 0x51, 0xbe, 0x86, 0xcb, 0x74, 0x06, 0x61, 0x43, 0x54,
+	0xc5, 0xd0, 0x3d, 0x90, 0x67, 0x27, 0x17, 0x82, 0x57, 0x67, 0xe6, 0x56, 0x5a, 0xb0, 0xee, 0x9f,
+	0x41, 0xe8, 0x7f, 0x1b, 0x56, 0x84, 0x8b, 0x7c, 0xba, 0x2f, 0x0b, 0xe0, 0x02, 0xbd, 0xbf, 0x82,
+	0xac, 0x90, 0xf8, 0x54, 0x1f, 0x10, 0x74, 0x0d, 0x32, 0x7d, 0xdd, 0x24, 0x3d, 0x8f, 0xba, 0x2e,
+	0x00, 0x21, 0x48, 0x78, 0x34, 0x64, 0xff, 0x51, 0x1e, 0x96, 0x7a, 0xc6, 0xc8, 0x26, 0x23, 0x3b,
+	0x1f, 0x67, 0x60, 0x67, 0xa8, 0xfc, 0x50, 0x82, 0xb5, 0x7d, 0xe2, 0x28, 0xfc, 0xfe, 0x3d, 0x82,
+	0x6e, 0xc1, 0xb2, 0xd7, 0x72, 0x31, 0x7f, 0xd6, 0x63, 0xb8, 0xd2, 0x01, 0xe4, 0x55, 0x41, 0xb8,
+	0x6c, 0x0f, 0x92, 0xc7, 0xfa, 0x40, 0xb8, 0x2a, 0xbb, 0x77, 0xad, 0x38, 0x13, 0x6a, 0x45, 0x8f,
+	0x4b, 0x30, 0x27, 0x9d, 0xef, 0xc1, 0x1f, 0x4b, 0xb0, 0xd1, 0x30, 0xc9, 0x1b, 0x9d, 0xbc, 0xbd,
+	0x50, 0x53, 0xff, 0x2c, 0xc1, 0xe6, 0xac, 0x1e, 0xc2, 0xde, 0x47, 0x90, 0x31, 0x06, 0x7d, 0x2d,
+	0xba, 0xcd, 0x69, 0x63, 0xd0, 0x7f, 0xca, 0xcc, 0x7e, 0x04, 0x99, 0x11, 0x79, 0x2b, 0x58, 0x63,
+	0x51, 0x58, 0x47, 0xe4, 0x2d, 0x67, 0x45, 0x90, 0xe8, 0xeb, 0xc7, 0xc7, 0x42, 0x57, 0xf6, 0xdf,
+	0xeb, 0xc5, 0x44, 0xc0, 0x8b, 0x35, 0x42, 0xfa, 0x56, 0xc3, 0x34, 0x86, 0x06, 0xb5, 0xfb, 0x62,
+	0xbc, 0xf8, 0x0a, 0x36, 0x67, 0xd5, 0x10, 0x4e, 0xdc, 0x81, 0xd5, 0x11, 0xc5, 0x68, 0x63, 0x07,
+	0xc5, 0x94, 0x49, 0xe3, 0xdc, 0xc8, 0xc7, 0x30, 0x3f, 0x52, 0xfe, 0x25, 0xc1, 0x56, 0x69, 0x3c,
+	0x36, 0x8d, 0x37, 0xe4, 0x62, 0xad, 0x44, 0x77, 0x61, 0x75, 0x3c, 0x19, 0x0c, 0x34, 0x93, 0xcf,
+	0xae, 0xe9, 0x7d, 0xb6, 0x1c, 0x71, 0xbc, 0x42, 0xc1, 0x42, 0xa7, 0x6a, 0x1f, 0x15, 0x20, 0xdd,
+	0xe1, 0x0a, 0x9b, 0xf9, 0x24, 0x13, 0x33, 0x1d, 0xd3, 0xc4, 0x1f, 0x12, 0xcb, 0xea, 0x9c, 0x90,
+	0x7c, 0x8a, 0x27, 0xbe, 0x18, 0x2a, 0x4f, 0x20, 0x1f, 0x34, 0x53, 0x78, 0x31, 0x64, 0x66, 0x29,
+	0x64, 0x66, 0xe5, 0xf7, 0x12, 0x6c, 0x1c, 0x12, 0xf3, 0xe4, 0x7f, 0xc4, 0x53, 0xca, 0xe7, 0xb0,
+	0x39, 0xab, 0xee, 0x3b, 0x5a, 0xfc, 0x0f, 0x09, 0x36, 0x9a, 0x76, 0xc7, 0xb4, 0x2f, 0xd8, 0xe2,
+	0x75, 0x48, 0x76, 0x68, 0xb9, 0x17, 0x09, 0xca, 0x07, 0xe8, 0x53, 0xb8, 0x42, 0x57, 0xfd, 0xad,
+	0xa9, 0xdb, 0x44, 0x3b, 0x36, 0x4c, 0xa2, 0x9f, 0x8c, 0xb4, 0x9e, 0x31, 0x1c, 0xea, 0xb6, 0xc5,
+	0x42, 0x23, 0x8d, 0xb7, 0xa6, 0x04, 0x4f, 0x39, 0xbe, 0xcc, 0xd1, 0x4a, 0x1f, 0x36, 0x67, 0x0d,
+	0x9b, 0xfa, 0x26, 0xfe, 0xda, 0xe8, 0x32, 0x93, 0xb2, 0x7b, 0xeb, 0x81, 0xba, 0xf2, 0xdc, 0xe8,
+	0x62, 0x4a, 0x80, 0x14, 0x58, 0xee, 0x93, 0xfe, 0x84, 0xdb, 0x42, 0xfa, 0xcc, 0xba, 0x34, 0xf6,
+	0xc1, 0x94, 0xbb, 0xb0, 0xb2, 0x4f, 0x6c, 0xca, 0x22, 0xdc, 0xb6, 0x01, 0xa9, 0xd7, 0x46, 0xd7,
+	0xf1, 0x77, 0x06, 0x27, 0x5f, 0x1b, 0xdd, 0x6a, 0x5f, 0xf9, 0x16, 0xe4, 0x1c, 0xba, 0x77, 0xd3,
+	0x42, 0xf9, 0xa5, 0x04, 0xab, 0x74, 0x0b, 0x7e, 0x6e, 0x74, 0xad, 0x0b, 0x5b, 0x9b, 0x81, 0x3e,
+	0xd4, 0x6d, 0xb6, 0x36, 0x49, 0xcc, 0x07, 0xca, 0xb7, 0x41, 0x76, 0xd5, 0x12, 0x36, 0xed, 0x42,
+	0xe2, 0xb5, 0xd1, 0x75, 0xaa, 0x7d, 0xb8, 0x51, 0x8c, 0x42, 0xf9, 0x5d, 0x02, 0xe2, 0xcf, 0x8d,
+	0x2e, 0xca, 0x41, 0x6c, 0xea, 0xaa, 0x98, 0xde, 0x9f, 0xb1, 0x2c, 0x16, 0xc9, 0xb2, 0x78, 0x34,
+	0xcb, 0x12, 0xe7, 0x44, 0x5d, 0xd2, 0x1b, 0x75, 0x0f, 0x21, 0x69, 0xd9, 0x1d, 0x9b, 0x57, 0x98,
+	0xdc, 0x5e, 0x21, 0xcc, 0x8c, 0x62, 0x93, 0x52, 0x60, 0x4e, 0x88, 0x8a, 0x94, 0x83, 0x8c, 0xad,
+	0xfc, 0x12, 0x33, 0x3c, 0x1f, 0xc6, 0xd1, 0xb4, 0xc9, 0x18, 0x73, 0x32, 0xba, 0x49, 0x0d, 0x8c,
+	0x13, 0x2b, 0x9f, 0x66, 0x4d, 0x13, 0xfb, 0x8f, 0x3e, 0x83, 0x94, 0x49, 0xac, 0xc9, 0xc0, 0xce,
+	0x67, 0x58, 0x48, 0xdc, 0x0d, 0x08, 0x69, 0x4c, 0xac, 0xd3, 0x40, 0x34, 0x63, 0xc1, 0x45, 0x6d,
+	0x21, 0xa6, 0x69, 0x98, 0x79, 0xe0, 0xb6, 0xb0, 0x01, 0xba, 0x0e, 0xd0, 0x33, 0x09, 0x0d, 0x55,
+	0xad, 0x63, 0xe7, 0xb3, 0x0c, 0x95, 0x11, 0x90, 0x92, 0x4d, 0xd1, 0x16, 0x4d, 0x12, 0x8e, 0x5e,
+	0xe6, 0x68, 0x01, 0x29, 0xd9, 0xe8, 0x26, 0x64, 0x8f, 0xf5, 0x91, 0x6e, 0x9d, 0x72, 0xfc, 0x0a,
+	0x5f, 0x0e, 0x07, 0x54, 0xb2, 0x95, 0x63, 0x48, 0x32, 0x47, 0xa0, 0x35, 0x58, 0x69, 0xb6, 0x4a,
+	0x2d, 0x55, 0x6b, 0xd7, 0xbe, 0xa8, 0xd5, 0x5f, 0xd6, 0xe4, 0x4b, 0x48, 0x86, 0x65, 0x0e, 0x7a,
+	0xd1, 0x56, 0xdb, 0x6a, 0x45, 0x96, 0x5c, 0x22, 0xdc, 0xae, 0xd5, 0xaa, 0xb5, 0x7d, 0x39, 0x86,
+	0x2e, 0xc3, 0x2a, 0x07, 0x35, 0xdb, 0xe5, 0xb2, 0xaa, 0x56, 0xd4, 0x8a, 0x1c, 0x77, 0x39, 0x9f,
+	0x96, 0xaa, 0x07, 0x6a, 0x45, 0x4e, 0x28, 0xff, 0x94, 0x60, 0x49, 0xf8, 0x70, 0xda, 0x0f, 0x4a,
+	0x9e, 0x7e, 0xf0, 0x1b, 0xce, 0x92, 0xc5, 0xd8, 0x92, 0xdd, 0x98, 0xb7, 0x00, 0xfe, 0x65, 0xf3,
+	0x5b, 0x1f, 0x5f, 0x60, 0x7d, 0x22, 0x60, 0x7d, 0xfd, 0x1c, 0xeb, 0x03, 0xb6, 0x4a, 0x28, 0x07,
+	0xc0, 0x41, 0x95, 0x7a, 0x4d, 0x95, 0x63, 0x01, 0x33, 0xe3, 0xca, 0x4f, 0x24, 0xd8, 0xa4, 0x49,
+	0x55, 0x21, 0xe3, 0x81, 0x71, 0x36, 0x24, 0x23, 0xfb, 0x62, 0x52, 0x5e, 0xf9, 0x12, 0xb6, 0x02,
+	0x7a, 0x88, 0x1c, 0x7f, 0x0c, 0xd9, 0xbe, 0x0b, 0x16, 0xa9, 0x7e, 0x35, 0xe0, 0x70, 0x97, 0x15,
+	0x7b, 0xe9, 0x95, 0x5f, 0x25, 0x00, 0x5c, 0x9c, 0x27, 0xff, 0xe3, 0x2c, 0xff, 0x65, 0x88, 0xbb,
+	0x2d, 0x0c, 0xfd, 0x8b, 0xb6, 0x21, 0x4b, 0x46, 0x6f, 0x74, 0xd3, 0x18, 0x0d, 0xdd, 0x76, 0xdf,
+	0x0b, 0x0a, 0x35, 0x3d, 0x11, 0xcd, 0xf4, 0x64, 0xb0, 0x26, 0x7c, 0x00, 0x6b, 0xc7, 0xa6, 0x31,
+	0xd4, 0x7c, 0x74, 0xbc, 0xd7, 0x58, 0xa5, 0x08, 0xec, 0xa1, 0xdd, 0x05, 0xd9, 0x30, 0xf5, 0x13,
+	0x7d, 0xd4, 0x19, 0x68, 0x4e, 0xf7, 0xb5, 0xc4, 0x48, 0x73, 0x0e, 0x7c, 0x9f, 0x75, 0x61, 0x61,
+	0xfb, 0x71, 0x3a, 0xac, 0xf7, 0xf9, 0xc4, 0x09, 0xe4, 0x0c, 0x0b, 0xe4, 0x5b, 0xe7, 0xf8, 0x35,
+	0x10, 0xcb, 0x9e, 0x44, 0x87, 0x99, 0x44, 0x57, 0x7e, 0x2d, 0xfd, 0x77, 0x99, 0xda, 0x50, 0x6b,
+	0x15, 0x9e, 0xa9, 0x1b, 0xb0, 0xc6, 0x41, 0xd5, 0x9a, 0xd6, 0xc0, 0xf5, 0x7d, 0xac, 0x36, 0x9b,
+	0x72, 0xdc, 0xa5, 0x64, 0x09, 0xdc, 0x6c, 0xca, 0x09, 0x17, 0x44, 0xe3, 0xba, 0x8d, 0x55, 0x39,
+	0x89, 0x56, 0x21, 0xcb, 0x41, 0x2a, 0xc6, 0x75, 0x2c, 0xa7, 0x10, 0x82, 0x9c, 0x23, 0xad, 0x54,
+	0x6e, 0x55, 0x8f, 0x54, 0x79, 0x49, 0xf9, 0x8b, 0x04, 0x85, 0x26, 0xf1, 0x04, 0x1d, 0x55, 0x78,
+	0x12, 0x39, 0x03, 0x6e, 0xc3, 0x8a, 0x1b, 0x68, 0xd4, 0xc1, 0x31, 0xe6, 0xe0, 0x65, 0x17, 0xe8,
+	0xf5, 0x6f, 0xfc, 0x1d, 0xfd, 0xbb, 0x4d, 0xc3, 0xde, 0xea, 0x99, 0xfa, 0x98, 0x35, 0xe1, 0x62,
+	0x33, 0xf1, 0x80, 0x94, 0xeb, 0x70, 0x35, 0x54, 0x7b, 0x9e, 0x37, 0xca, 0x4b, 0xb8, 0x86, 0x49,
+	0xcf, 0x18, 0xf5, 0xf4, 0x01, 0x69, 0xb8, 0x6b, 0x1e, 0xd9, 0xbc, 0x2d, 0x58, 0xea, 0x9b, 0x67,
+	0x9a, 0x39, 0x19, 0x89, 0x46, 0x24, 0xd5, 0x37, 0xcf, 0xf0, 0x64, 0xa4, 0x4c, 0xe0, 0xfa, 0x1c,
+	0xc1, 0x22, 0x63, 0x5b, 0xb0, 0xde, 0x1b, 0x18, 0x16, 0xe9, 0x6b, 0xde, 0x10, 0x74, 0x52, 0x57,
+	0x09, 0xb8, 0xa0, 0xcc, 0x88, 0x3d, 0xa2, 0x30, 0xea, 0xcd, 0x82, 0x2c, 0xe5, 0x67, 0x12, 0xac,
+	0x05, 0x28, 0x17, 0x5a, 0x11, 0x92, 0x07, 0xb1, 0xb0, 0x3c, 0xd8, 0x84, 0x54, 0xd7, 0xec, 0x8c,
+	0x7a, 0xa7, 0x22, 0xe1, 0xc5, 0x88, 0xc2, 0x4d, 0xd2, 0xb1, 0xa6, 0x2b, 0x20, 0x46, 0xca, 0xbf,
+	0x25, 0xd8, 0x54, 0x47, 0x9d, 0xee, 0x80, 0x94, 0x26, 0xb6, 0xc1, 0x9a, 0xe2, 0xf7, 0xad, 0xd2,
+	0x11, 0x2c, 0x0f, 0xa9, 0x5c, 0x6d, 0x48, 0xec, 0x53, 0xa3, 0x2f, 0x22, 0xe8, 0xe3, 0x80, 0xfb,
+	0xc2, 0xd5, 0x28, 0xb2, 0xc1, 0x21, 0x63, 0xc5, 0xd9, 0xa1, 0x3b, 0x50, 0x1e, 0x42, 0xd6, 0x83,
+	0x43, 0x00, 0xa9, 0xe6, 0x8b, 0x76, 0xa9, 0xf9, 0x4c, 0xbe, 0x84, 0x32, 0x90, 0x3c, 0x54, 0xf1,
+	0xbe, 0x2a, 0x4b, 0x14, 0x8c, 0xd5, 0x27, 0xa5, 0xa6, 0x2a, 0xc7, 0x94, 0x2b, 0xb0, 0x15, 0x98,
+	0x44, 0x44, 0xd9, 0xa7, 0x90, 0xc7, 0xe4, 0xd8, 0x24, 0xd6, 0x29, 0x9e, 0x5a, 0x18, 0xf5, 0xbe,
+	0xea, 0x2a, 0x5c, 0x09, 0xe1, 0x15, 0x82, 0xff, 0x2e, 0xc1, 0xfa, 0x4c, 0x03, 0xc2, 0xa5, 0x86,
+	0x55, 0x5f, 0x29, 0x5a, 0xf5, 0x8d, 0x05, 0xab, 0xaf, 0x5f, 0xc7, 0x78, 0x60, 0xb1, 0xde, 0xff,
+	0x39, 0xe1, 0xb7, 0x31, 0xd8, 0x08, 0xed, 0xac, 0x90, 0x0a, 0x29, 0x8b, 0xe5, 0x30, 0xb3, 0x27,
+	0xb7, 0x77, 0x3f, 0x5a, 0x47, 0x56, 0x14, 0x89, 0x2f, 0x98, 0x23, 0xc7, 0x17, 0xad, 0xe0, 0x4c,
+	0x27, 0xb6, 0x8d, 0x88, 0x6e, 0x84, 0x43, 0xe8, 0x39, 0xfe, 0x47, 0x12, 0xa4, 0xb8, 0x64, 0x94,
+	0x85, 0x25, 0xb7, 0x78, 0x5f, 0x81, 0x0d, 0xf5, 0xcb, 0x6a, 0xb3, 0x55, 0xad, 0xed, 0x6b, 0x8d,
+	0xf6, 0xc1, 0x81, 0x86, 0xd5, 0x17, 0x6d, 0xb5, 0xd9, 0x92, 0x25, 0xb4, 0x0e, 0x72, 0x4d, 0x7d,
+	0xe9, 0x87, 0xc6, 0x68, 0x1b, 0x52, 0xab, 0x6b, 0xe5, 0x67, 0xa5, 0xda, 0xbe, 0x2a, 0x2a, 0x78,
+	0xa5, 0x8a, 0xd5, 0x72, 0x4b, 0x2b, 0xd7, 0x0f, 0x0f, 0xab, 0x2d, 0x39, 0x81, 0xf2, 0xb0, 0xde,
+	0x6e, 0x54, 0x4a, 0x2d, 0xb5, 0xe2, 0x67, 0x4e, 0x2a, 0x9f, 0xc3, 0x8d, 0x7d, 0x62, 0x97, 0x06,
+	0x03, 0xcf, 0xad, 0xe6, 0x3b, 0x95, 0x69, 0xe5, 0x0f, 0x12, 0xdc, 0x9c, 0x2b, 0x42, 0x78, 0xfe,
+	0x05, 0x20, 0x6f, 0x4c, 0x4d, 0x57, 0x21, 0xbc, 0x5e, 0x05, 0xe5, 0xac, 0x75, 0x66, 0x41, 0xe8,
+	0x31, 0x64, 0xac, 0x51, 0x67, 0x6c, 0x9d, 0x1a, 0xb6, 0x73, 0xa5, 0x74, 0x33, 0x20, 0x89, 0xd3,
+	0x36, 0x05, 0x1d, 0x76, 0x39, 0x94, 0xbf, 0x4a, 0x90, 0xf3, 0x63, 0xa3, 0x14, 0xec, 0xd0, 0x1b,
+	0x19, 0xda, 0x57, 0xf6, 0x8c, 0xe1, 0x78, 0xe2, 0xeb, 0x3b, 0xc1, 0x01, 0x95, 0x6c, 0x54, 0x84,
+	0xcb, 0x62, 0xa4, 0xf5, 0x27, 0x26, 0xf7, 0xc1, 0xd0, 0x12, 0x57, 0x00, 0x6b, 0x02, 0x55, 0x11,
+	0x98, 0x43, 0x0b, 0x7d, 0x02, 0x5b, 0x26, 0x71, 0x45, 0xba, 0xb6, 0xd3, 0xe0, 0xa7, 0x27, 0x8c,
+	0x4d, 0x17, 0xed, 0x71, 0x96, 0xa5, 0xfc, 0x5c, 0x82, 0xb5, 0x80, 0xf7, 0x42, 0x1b, 0x6c, 0x15,
+	0x72, 0x4e, 0xea, 0x8a, 0xd5, 0xe0, 0x3e, 0xbc, 0x31, 0xef, 0x5a, 0x4e, 0xac, 0xc4, 0x8a, 0xe9,
+	0x1d, 0x2e, 0x4a, 0x6f, 0xe5, 0x37, 0x31, 0x58, 0xf1, 0x09, 0x08, 0x55, 0xe6, 0xf1, 0x34, 0x31,
+	0x79, 0xbb, 0x7f, 0xe7, 0x7c, 0x25, 0x66, 0x13, 0xf2, 0x2a, 0x64, 0xc6, 0xa6, 0x36, 0x9a, 0x0c,
+	0xbb, 0xc4, 0x64, 0x3a, 0xc4, 0x71, 0x7a, 0x6c, 0xd6, 0xd8, 0x38, 0xb4, 0xa5, 0x4b, 0x84, 0xb6,
+	0x74, 0x2a, 0x2c, 0x7b, 0xf3, 0x9a, 0xd5, 0x99, 0xb0, 0xf0, 0xf4, 0x6c, 0x8f, 0x42, 0x91, 0xac,
+	0x27, 0xf1, 0x95, 0x87, 0xe1, 0x69, 0x9d, 0x85, 0x25, 0xa7, 0xf7, 0x92, 0xd0, 0x32, 0xa4, 0xb1,
+	0x7a, 0xa0, 0x96, 0x9a, 0x6a, 0x45, 0x8e, 0x29, 0x3f, 0x4d, 0xc1, 0x5a, 0x40, 0x28, 0xfa, 0xcc,
+	0xe9, 0x6c, 0x78, 0xb1, 0xda, 0x5d, 0xac, 0x87, 0xbf, 0xc1, 0x79, 0x05, 0xab, 0xfc, 0x1e, 0x57,
+	0xeb, 0x93, 0x9e, 0x6e, 0xd1, 0x26, 0x87, 0x7b, 0xf7, 0xff, 0x23, 0x48, 0xc2, 0x8c, 0xb3, 0x22,
+	0x18, 0x71, 0xce, 0xf4, 0x8d, 0xe9, 0x05, 0x3f, 0xbf, 0xc1, 0xeb, 0x0c, 0x2c, 0xe6, 0xf1, 0x24,
+	0x76, 0x01, 0xe8, 0x39, 0x64, 0xd8, 0x7e, 0x48, 0x77, 0x34, 0xe6, 0xeb, 0xdc, 0xde, 0x47, 0x11,
+	0xe6, 0x3c, 0x74, 0x78, 0xb0, 0xcb, 0x8e, 0x4a, 0x90, 0xea, 0x9d, 0x92, 0xde, 0xf7, 0x78, 0xd9,
+	0xcf, 0xed, 0xdd, 0x8b, 0x20, 0xa8, 0xcc, 0x18, 0xb0, 0x60, 0xa4, 0x5b, 0x4c, 0xdf, 0xec, 0x1c,
+	0xdb, 0xac, 0xe9, 0x4f, 0x63, 0x3e, 0x50, 0x6a, 0xe7, 0xf4, 0xcf, 0xd3, 0x83, 0x5d, 0xbd, 0xa1,
+	0xd6, 0x64, 0xc9, 0xed, 0xa7, 0xd9, 0xe6, 0x5d, 0xf1, 0x1e, 0xf5, 0xca, 0x07, 0xf5, 0x26, 0x3b,
+	0xea, 0xfd, 0x42, 0x82, 0x9c, 0xdf, 0x6b, 0xb4, 0xea, 0x62, 0xf5, 0xa8, 0xaa, 0xbe, 0xd4, 0x2a,
+	0x6a, 0xb9, 0xda, 0xac, 0xd6, 0x6b, 0x5a, 0x8d, 0x9e, 0x14, 0x2f, 0xa1, 0x6b, 0x90, 0x9f, 0xc5,
+	0x94, 0x1a, 0x0d, 0x5c, 0x3f, 0x62, 0xcd, 0xfa, 0x1d, 0xb8, 0x35, 0x8b, 0x15, 0xd5, 0xdd, 0x29,
+	0xdc, 0x4c, 0x87, 0xdb, 0x70, 0x73, 0x96, 0x4c, 0x8c, 0x29, 0x55, 0x15, 0x33, 0xb5, 0x8e, 0x20,
+	0x33, 0xf5, 0x2b, 0x6d, 0xf9, 0x99, 0x05, 0xa5, 0x27, 0x07, 0x5e, 0x73, 0xb7, 0xe0, 0xb2, 0x0b,
+	0x9e, 0xfe, 0x93, 0x25, 0xba, 0x15, 0xb9, 0x88, 0x72, 0xbd, 0xf6, 0xf4, 0xa0, 0x5a, 0x6e, 0xb1,
+	0xd3, 0x83, 0xd2, 0x86, 0x14, 0x77, 0x33, 0x3d, 0x0a, 0x94, 0x9f, 0xa9, 0xe5, 0x2f, 0x9a, 0x8e,
+	0x71, 0x08, 0x72, 0x02, 0xe0, 0x06, 0xbc, 0x0b, 0x73, 0x8e, 0x15, 0x31, 0x0f, 0xcc, 0x39, 0x57,
+	0xc4, 0x95, 0xbf, 0x25, 0x21, 0xcb, 0x57, 0x51, 0x7d, 0xe3, 0x3f, 0x4e, 0xf2, 0xeb, 0xa4, 0x6f,
+	0x42, 0xc2, 0x3e, 0x1b, 0x3b, 0xd7, 0x02, 0xb7, 0xe6, 0x14, 0x7c, 0xc6, 0x5b, 0x6c, 0x9d, 0x8d,
+	0x09, 0x66, 0xe4, 0x0b, 0xbb, 0x90, 0xf7, 0x7b, 0xe2, 0x0c, 0xd6, 0xd6, 0xd4, 0xb6, 0xf4, 0xee,
+	0xb5, 0xf5, 0x08, 0xb6, 0xc6, 0x34, 0xef, 0x8c, 0x89, 0xa5, 0xcd, 0xc8, 0x5b, 0x8a, 0x24, 0x6f,
+	0xc3, 0x61, 0xf7, 0x81, 0x23, 0x1f, 0x5d, 0x11, 0x24, 0x6c, 0x7d, 0xc8, 0x4f, 0xae, 0x19, 0xcc,
+	0xfe, 0x2b, 0x7f, 0x8c, 0x41, 0x82, 0xfa, 0x95, 0x26, 0x40, 0xeb, 0xab, 0xc6, 0x4c, 0xd2, 0x30,
+	0x08, 0x56, 0x9b, 0x2a, 0x6d, 0x56, 0x36, 0x01, 0x89, 0x31, 0x2b, 0x74, 0x5a, 0xa9, 0x52, 0x61,
+	0x61, 0x9b, 0x87, 0x75, 0x1f, 0x1c, 0xab, 0x87, 0x2c, 0xee, 0xe3, 0x01, 0x8c, 0x13, 0x3e, 0x09,
+	0x1a, 0x88, 0x33, 0x3c, 0xa2, 0x78, 0x26, 0x69, 0x2a, 0x31, 0x94, 0xb7, 0xaf, 0x61, 0x79, 0xab,
+	0x56, 0xe4, 0x14, 0xba, 0x0e, 0x57, 0x82, 0x58, 0xd1, 0x0a, 0xc9, 0x4b, 0xe1, 0xcc, 0x22, 0xc9,
+	0xd3, 0xe1, 0x58, 0x91, 0xf0, 0x19, 0x7a, 0xd3, 0xc5, 0xb0, 0xcf, 0xea, 0x07, 0x15, 0x61, 0x1c,
+	0xd0, 0x0c, 0x73, 0x81, 0x8e, 0x65, 0xd9, 0xbd, 0x3f, 0xad, 0x40, 0x5a, 0x2c, 0x82, 0x89, 0x7e,
+	0x00, 0x5b, 0x73, 0xfa, 0x25, 0xf4, 0x20, 0xb0, 0xa2, 0xe7, 0x37, 0x67, 0x85, 0x87, 0xd1, 0x19,
+	0x44, 0x2b, 0xf6, 0x5d, 0x58, 0xf1, 0x75, 0xb9, 0xe8, 0xce, 0xa2, 0x2e, 0x98, 0xcf, 0x14, 0xf1,
+	0xfa, 0x12, 0xbd, 0x86, 0xb5, 0xc0, 0xa1, 0x03, 0xdd, 0x0b, 0x89, 0xd4, 0xf0, 0x43, 0x4d, 0xe1,
+	0x83, 0x28, 0xa4, 0x62, 0xae, 0x3e, 0xac, 0xce, 0x9c, 0x9b, 0xd0, 0x4e, 0xc4, 0xe3, 0x5b, 0x61,
+	0x77, 0x31, 0xa1, 0x98, 0xe5, 0x0d, 0x6c, 0x84, 0x9e, 0xc7, 0xd1, 0xfd, 0x10, 0x55, 0xe7, 0x5f,
+	0x08, 0x14, 0x8a, 0x51, 0xc9, 0x5d, 0xeb, 0x66, 0xee, 0xec, 0x42, 0xac, 0x0b, 0xbf, 0x5d, 0x2c,
+	0xec, 0x2e, 0x26, 0x14, 0xb3, 0x8c, 0xe1, 0x72, 0xc8, 0x2d, 0x07, 0xfa, 0x30, 0x58, 0x5a, 0xe7,
+	0xde, 0xe4, 0x14, 0x3e, 0x8a, 0x46, 0x2c, 0x66, 0x3c, 0xe1, 0x0f, 0x0d, 0xde, 0xc6, 0x15, 0x85,
+	0xeb, 0x1b, 0xf2, 0x91, 0x46, 0xe1, 0x5e, 0x04, 0x4a, 0x31, 0xd1, 0x77, 0x60, 0xd9, 0xfb, 0xad,
+	0x03, 0xfa, 0xbf, 0x50, 0xd6, 0x99, 0x8f, 0x2d, 0x0a, 0x77, 0x16, 0x50, 0x09, 0xe1, 0x6d, 0x00,
+	0xf7, 0x9b, 0x00, 0xa4, 0x84, 0xe5, 0xa1, 0xff, 0x21, 0xbf, 0x70, 0xfb, 0x5c, 0x1a, 0x21, 0xb6,
+	0x03, 0x39, 0xff, 0xf3, 0x3b, 0x0a, 0x49, 0xbc, 0xb0, 0xef, 0x04, 0x0a, 0x3b, 0x0b, 0xe9, 0xdc,
+	0x29, 0xfc, 0x8f, 0xd3, 0x21, 0x53, 0x84, 0x3e, 0xa2, 0x17, 0x76, 0x16, 0xd2, 0xb9, 0x4b, 0x3c,
+	0xfb, 0x76, 0x1b, 0xb2, 0xc4, 0x73, 0x5e, 0xb1, 0x0b, 0xf7, 0x22, 0x50, 0xba, 0xb6, 0xf8, 0x1f,
+	0x4c, 0x43, 0x6c, 0x09, 0x7d, 0x00, 0x2e, 0xec, 0x2c, 0xa4, 0x73, 0xa7, 0xf0, 0xbf, 0x3b, 0x86,
+	0x4c, 0x11, 0xfa, 0xe2, 0x5a, 0xd8, 0x59, 0x48, 0x27, 0xa6, 0xa8, 0x42, 0x8a, 0x3f, 0x26, 0xa2,
+	0x1b, 0x61, 0x31, 0xe2, 0xbe, 0x46, 0x16, 0x6e, 0xce, 0xc5, 0x0b, 0x51, 0x75, 0x48, 0x3b, 0xaf,
+	0x78, 0x68, 0x3b, 0x34, 0x92, 0x3d, 0xef, 0x8e, 0x85, 0x5b, 0xe7, 0x50, 0x70, 0x81, 0x4f, 0x1e,
+	0xbe, 0x2a, 0x9e, 0xe8, 0xf6, 0xe9, 0xa4, 0x5b, 0xec, 0x19, 0xc3, 0x07, 0x9c, 0x5c, 0xfc, 0xdc,
+	0x9f, 0x7e, 0x74, 0xe5, 0xfd, 0x02, 0xab, 0x9b, 0x62, 0x5f, 0x5e, 0x7d, 0xfc, 0x9f, 0x01, 0x00,
+	0x03, 0x3a, 0xbf, 0xdc, 0x98, 0x25, 0x00, 0x00,
 }